@@ -0,0 +1,243 @@
+package hijri
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MaxAdjustment is the maximum number of days that a month start can be moved by AdjustedCalendar.
+const MaxAdjustment = 2
+
+// Adjustment is a correction for the start of a Hijri month, in days. Positive value means the
+// month starts later than its calculation, while negative means it starts earlier.
+type Adjustment struct {
+	Year  int64
+	Month int64
+	Days  int64
+}
+
+// AdjustedCalendar is a calendar that moves the month starts of its base calendar by a few days,
+// e.g. to follow the moon-sighting committee when their announcement differs from calculation.
+// It's safe for concurrent use, so the adjustments can be updated while other goroutines are
+// converting dates, and every conversion after the update will use the new month starts.
+type AdjustedCalendar struct {
+	base        Calendar
+	mutex       sync.RWMutex
+	adjustments map[int64]int64
+}
+
+// NewAdjustedCalendar creates a calendar with the specified base and initial adjustments.
+func NewAdjustedCalendar(base Calendar, adjustments ...Adjustment) (*AdjustedCalendar, error) {
+	ac := &AdjustedCalendar{
+		base:        base,
+		adjustments: map[int64]int64{},
+	}
+
+	if err := ac.SetAdjustments(adjustments); err != nil {
+		return nil, err
+	}
+
+	return ac, nil
+}
+
+// MonthStart returns the Gregorian date of the first day of the specified Hijri month.
+func (ac *AdjustedCalendar) MonthStart(year, month int64) (time.Time, error) {
+	if month < 1 || month > 12 {
+		return time.Time{}, errors.New("month must be between 1 and 12")
+	}
+
+	return ac.Snapshot().MonthStart(year, month)
+}
+
+// Snapshot returns a calendar with the current adjustments, which won't change even when the
+// adjustments are updated later. CreateDate and the other functions that need several month
+// starts use it, so a conversion never mixes the month starts from before and after an update.
+func (ac *AdjustedCalendar) Snapshot() Calendar {
+	ac.mutex.RLock()
+	defer ac.mutex.RUnlock()
+	return &adjustedSnapshot{base: ac.base, adjustments: ac.adjustments}
+}
+
+// Adjustments returns the list of current adjustments.
+func (ac *AdjustedCalendar) Adjustments() []Adjustment {
+	ac.mutex.RLock()
+	adjustments := ac.adjustments
+	ac.mutex.RUnlock()
+
+	list := make([]Adjustment, 0, len(adjustments))
+	for iln, days := range adjustments {
+		year, month := lunationMonth(iln)
+		list = append(list, Adjustment{Year: year, Month: month, Days: days})
+	}
+
+	sort.Slice(list, func(a, b int) bool {
+		return lunationNumber(list[a].Year, list[a].Month) < lunationNumber(list[b].Year, list[b].Month)
+	})
+
+	return list
+}
+
+// Adjust sets the adjustment for a single month while keeping the others. Use zero days to
+// remove the adjustment for the month.
+func (ac *AdjustedCalendar) Adjust(year, month, days int64) error {
+	if month < 1 || month > 12 {
+		return errors.New("month must be between 1 and 12")
+	}
+
+	// Hold the lock for the whole update, so concurrent Adjust calls are not lost
+	ac.mutex.Lock()
+	defer ac.mutex.Unlock()
+
+	adjustments := map[int64]int64{}
+	for iln, d := range ac.adjustments {
+		adjustments[iln] = d
+	}
+
+	adjustments[lunationNumber(year, month)] = days
+	return ac.replace(adjustments)
+}
+
+// SetAdjustments replaces all of the current adjustments at once.
+func (ac *AdjustedCalendar) SetAdjustments(adjustments []Adjustment) error {
+	newAdjustments := map[int64]int64{}
+	for _, adj := range adjustments {
+		if adj.Month < 1 || adj.Month > 12 {
+			return errors.New("month must be between 1 and 12")
+		}
+
+		iln := lunationNumber(adj.Year, adj.Month)
+		if _, exist := newAdjustments[iln]; exist {
+			return fmt.Errorf("month %04d-%02d is adjusted more than once", adj.Year, adj.Month)
+		}
+
+		newAdjustments[iln] = adj.Days
+	}
+
+	ac.mutex.Lock()
+	defer ac.mutex.Unlock()
+	return ac.replace(newAdjustments)
+}
+
+// Load replaces all of the current adjustments with the ones from CSV data, where each record is
+// the Hijri month in format YYYY-MM followed by the adjustment in days, e.g. "1447-09,+1".
+func (ac *AdjustedCalendar) Load(r io.Reader) error {
+	adjustments := []Adjustment{}
+	csvReader := csv.NewReader(r)
+	csvReader.FieldsPerRecord = 2
+	csvReader.Comment = '#'
+
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		year, month, _, err := parseHijriEntry(strings.TrimSpace(record[0]) + "-01")
+		if err != nil {
+			return err
+		}
+
+		days, err := strconv.ParseInt(strings.TrimSpace(record[1]), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid adjustment for %s: %v", record[0], err)
+		}
+
+		adjustments = append(adjustments, Adjustment{Year: year, Month: month, Days: days})
+	}
+
+	return ac.SetAdjustments(adjustments)
+}
+
+// LoadFile replaces all of the current adjustments with the ones from CSV file. See Load for the
+// format of the file. It can be called anytime, e.g. when the file is updated by the moon-sighting
+// announcement, without restarting the service that uses the calendar.
+func (ac *AdjustedCalendar) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return ac.Load(f)
+}
+
+// replace validates the new adjustments, then use it as the current adjustments. The caller must
+// hold the write lock.
+func (ac *AdjustedCalendar) replace(adjustments map[int64]int64) error {
+	for iln, days := range adjustments {
+		if days < -MaxAdjustment || days > MaxAdjustment {
+			year, month := lunationMonth(iln)
+			return fmt.Errorf("adjustment for %04d-%02d must be between %d and %d days",
+				year, month, -MaxAdjustment, MaxAdjustment)
+		}
+
+		if days == 0 {
+			delete(adjustments, iln)
+		}
+	}
+
+	// Make sure the adjusted month and the month before it still have valid length
+	for iln := range adjustments {
+		for _, month := range []int64{iln - 1, iln} {
+			start, err := adjustedLunationStart(ac.base, adjustments, month)
+			if err != nil {
+				return err
+			}
+
+			end, err := adjustedLunationStart(ac.base, adjustments, month+1)
+			if err != nil {
+				return err
+			}
+
+			if nDays := end - start; nDays != 29 && nDays != 30 {
+				year, month := lunationMonth(month)
+				return fmt.Errorf("adjusted month %04d-%02d has %d days", year, month, nDays)
+			}
+		}
+	}
+
+	ac.adjustments = adjustments
+	return nil
+}
+
+// adjustedSnapshot is the adjusted calendar at a point of time. The adjustments map is never
+// modified after it's replaced, so it can be shared without lock.
+type adjustedSnapshot struct {
+	base        Calendar
+	adjustments map[int64]int64
+}
+
+// MonthStart returns the Gregorian date of the first day of the specified Hijri month.
+func (as *adjustedSnapshot) MonthStart(year, month int64) (time.Time, error) {
+	if month < 1 || month > 12 {
+		return time.Time{}, errors.New("month must be between 1 and 12")
+	}
+
+	start, err := adjustedLunationStart(as.base, as.adjustments, lunationNumber(year, month))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return jdnToTime(start), nil
+}
+
+func adjustedLunationStart(base Calendar, adjustments map[int64]int64, iln int64) (int64, error) {
+	start, err := lunationStart(base, iln)
+	if err != nil {
+		return 0, err
+	}
+
+	return start + adjustments[iln], nil
+}
@@ -0,0 +1,180 @@
+package hijri_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_AdjustedCalendar_MonthStart(t *testing.T) {
+	// Umm al-Qura put 1 Dhu al-Hijjah 1436 H on 14 September 2015, while it's announced a day later
+	cal, err := hijri.NewAdjustedCalendar(hijri.UmmAlQura, hijri.Adjustment{Year: 1436, Month: 12, Days: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lengths, _ := hijri.MonthLengths(cal, 1436)
+	if result := fmt.Sprint(lengths[10:]); result != "[30 29]" {
+		t.Errorf("want [30 29] got %s\n", result)
+	}
+
+	arafah := time.Date(2015, 9, 23, 0, 0, 0, 0, time.UTC)
+	date, _ := hijri.CreateDate(arafah, cal)
+	if date.Day != 9 || date.Month != 12 {
+		t.Errorf("Arafah: got %04d-%02d-%02d\n", date.Year, date.Month, date.Day)
+	}
+
+	// Remove the adjustment
+	if err := cal.Adjust(1436, 12, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if adjustments := cal.Adjustments(); len(adjustments) != 0 {
+		t.Errorf("want no adjustments got %v\n", adjustments)
+	}
+
+	date, _ = hijri.CreateDate(arafah, cal)
+	if date.Day != 10 || date.Month != 12 {
+		t.Errorf("Eid: got %04d-%02d-%02d\n", date.Year, date.Month, date.Day)
+	}
+}
+
+func Test_AdjustedCalendar_Invalid(t *testing.T) {
+	cal, _ := hijri.NewAdjustedCalendar(hijri.UmmAlQura)
+	invalidAdjustments := []hijri.Adjustment{
+		{Year: 1446, Month: 9, Days: 3},
+		{Year: 1446, Month: 13, Days: 1},
+		// Sha'ban 1446 already has 30 days
+		{Year: 1446, Month: 9, Days: 1},
+	}
+
+	for _, adj := range invalidAdjustments {
+		if err := cal.Adjust(adj.Year, adj.Month, adj.Days); err == nil {
+			t.Errorf("want error for %v\n", adj)
+		}
+	}
+}
+
+func Test_AdjustedCalendar_LoadFile(t *testing.T) {
+	f, err := ioutil.TempFile("", "adjustments-*.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+
+	f.WriteString("# month,days\n1436-12,+1\n1437-12,1\n")
+	f.Close()
+
+	cal, _ := hijri.NewAdjustedCalendar(hijri.UmmAlQura)
+	if err := cal.LoadFile(f.Name()); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "[{1436 12 1} {1437 12 1}]"
+	if result := fmt.Sprint(cal.Adjustments()); result != expected {
+		t.Errorf("want %s got %s\n", expected, result)
+	}
+
+	// Invalid file must keep the previous adjustments
+	if err := cal.Load(strings.NewReader("1437-12,x\n")); err == nil {
+		t.Error("want error got nil")
+	}
+
+	if result := fmt.Sprint(cal.Adjustments()); result != expected {
+		t.Errorf("want %s got %s\n", expected, result)
+	}
+}
+
+func Test_AdjustedCalendar_Concurrent(t *testing.T) {
+	cal, err := hijri.NewAdjustedCalendar(hijri.UmmAlQura)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	date := time.Date(2015, 9, 14, 0, 0, 0, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				// Whether adjusted or not, it must be one of these dates
+				hijriDate, err := hijri.CreateDate(date, cal)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+
+				result := fmt.Sprintf("%04d-%02d-%02d", hijriDate.Year, hijriDate.Month, hijriDate.Day)
+				if result != "1436-12-01" && result != "1436-11-30" {
+					t.Errorf("got %s\n", result)
+					return
+				}
+			}
+		}()
+	}
+
+	// Adjust the month from several goroutines as well
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				if err := cal.Adjust(1436, 12, int64(j%2)); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func Test_AdjustedCalendar_Snapshot(t *testing.T) {
+	cal, err := hijri.NewAdjustedCalendar(hijri.UmmAlQura, hijri.Adjustment{Year: 1436, Month: 12, Days: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot := cal.Snapshot()
+	if err := cal.Adjust(1436, 12, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// The snapshot keeps the adjustment from before the update
+	start, err := snapshot.MonthStart(1436, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := start.Format("2006-01-02"); result != "2015-09-15" {
+		t.Errorf("snapshot: want 2015-09-15 got %s\n", result)
+	}
+
+	start, err = cal.MonthStart(1436, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := start.Format("2006-01-02"); result != "2015-09-14" {
+		t.Errorf("calendar: want 2015-09-14 got %s\n", result)
+	}
+
+	// Dates created from the adjusted calendar still refer to it, not to its snapshot
+	date, err := hijri.CreateDate(start, cal)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if date.Calendar != hijri.Calendar(cal) {
+		t.Errorf("date must use the adjusted calendar\n")
+	}
+}
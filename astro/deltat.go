@@ -0,0 +1,109 @@
+package astro
+
+// deltaTFirstYear is the first year in deltaTTable.
+const deltaTFirstYear = 1973
+
+// deltaTTable is the observed Delta T in seconds at the start of each year since 1973, taken from
+// the measurements of International Earth Rotation and Reference Systems Service (IERS).
+var deltaTTable = []float64{
+	43.37, 44.49, 45.48, 46.46, 47.52, 48.53, 49.59, 50.54, 51.38, 52.17, // 1973
+	52.96, 53.79, 54.34, 54.87, 55.32, 55.82, 56.30, 56.86, 57.57, 58.31, // 1983
+	59.12, 59.98, 60.78, 61.63, 62.29, 62.97, 63.47, 63.83, 64.09, 64.30, // 1993
+	64.47, 64.57, 64.69, 64.85, 65.15, 65.46, 65.78, 66.07, 66.32, 66.60, // 2003
+	66.91, 67.28, 67.64, 68.10, 68.59, 68.97, 69.22, 69.36, 69.36, 69.29, // 2013
+	69.20, 69.18, 69.14, // 2023
+}
+
+// DeltaT returns the difference between Terrestrial Time and Universal Time (TT - UT) in seconds
+// for the specified Julian Day. For modern years it's interpolated from the observed values, while
+// for the other years it uses the polynomial expressions by Espenak and Meeus that used in NASA's
+// Five Millennium Canon of Solar Eclipses. After the last observed year, the polynomial is shifted
+// so both of them are continuous.
+func DeltaT(jd float64) float64 {
+	// The table is interpolated using the exact fraction of year, since it's measured at the start
+	// of each year, while the polynomials use the month like in the original expressions.
+	y := 2000 + (jd-j2000+0.5)/365.25
+	lastYear := float64(deltaTFirstYear + len(deltaTTable) - 1)
+
+	switch {
+	case y < deltaTFirstYear:
+		return deltaTPolynomial(decimalYear(jd))
+	case y < lastYear:
+		idx := int(y - deltaTFirstYear)
+		fraction := y - float64(deltaTFirstYear+idx)
+		return deltaTTable[idx] + fraction*(deltaTTable[idx+1]-deltaTTable[idx])
+	default:
+		return deltaTTable[len(deltaTTable)-1] + deltaTPolynomial(decimalYear(jd)) - deltaTPolynomial(lastYear)
+	}
+}
+
+// deltaTPolynomial calculates Delta T using the polynomial expressions by Espenak and Meeus.
+func deltaTPolynomial(y float64) float64 {
+	switch {
+	case y < -500:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	case y < 500:
+		u := y / 100
+		return polynomial(u, 10583.6, -1014.41, 33.78311, -5.952053, -0.1798452, 0.022174192, 0.0090316521)
+	case y < 1600:
+		u := (y - 1000) / 100
+		return polynomial(u, 1574.2, -556.01, 71.23472, 0.319781, -0.8503463, -0.005050998, 0.0083572073)
+	case y < 1700:
+		t := y - 1600
+		return polynomial(t, 120, -0.9808, -0.01532, 1.0/7129)
+	case y < 1800:
+		t := y - 1700
+		return polynomial(t, 8.83, 0.1603, -0.0059285, 0.00013336, -1.0/1174000)
+	case y < 1860:
+		t := y - 1800
+		return polynomial(t, 13.72, -0.332447, 0.0068612, 0.0041116, -0.00037436,
+			0.0000121272, -0.0000001699, 0.000000000875)
+	case y < 1900:
+		t := y - 1860
+		return polynomial(t, 7.62, 0.5737, -0.251754, 0.01680668, -0.0004473624, 1.0/233174)
+	case y < 1920:
+		t := y - 1900
+		return polynomial(t, -2.79, 1.494119, -0.0598939, 0.0061966, -0.000197)
+	case y < 1941:
+		t := y - 1920
+		return polynomial(t, 21.20, 0.84493, -0.076100, 0.0020936)
+	case y < 1961:
+		t := y - 1950
+		return polynomial(t, 29.07, 0.407, -1.0/233, 1.0/2547)
+	case y < 1986:
+		t := y - 1975
+		return polynomial(t, 45.45, 1.067, -1.0/260, -1.0/718)
+	case y < 2005:
+		t := y - 2000
+		return polynomial(t, 63.86, 0.3345, -0.060374, 0.0017275, 0.000651814, 0.00002373599)
+	case y < 2050:
+		t := y - 2000
+		return polynomial(t, 62.92, 0.32217, 0.005589)
+	case y < 2150:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	default:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	}
+}
+
+// polynomial evaluates polynomial with the coefficients sorted from the lowest power.
+func polynomial(x float64, coefficients ...float64) float64 {
+	var result float64
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		result = result*x + coefficients[i]
+	}
+	return result
+}
+
+// ttToUT converts Julian Ephemeris Day (in TT) into Julian Day (in UT).
+func ttToUT(jde float64) float64 {
+	return jde - DeltaT(jde)/86400
+}
+
+// utToTT converts Julian Day (in UT) into Julian Ephemeris Day (in TT).
+func utToTT(jd float64) float64 {
+	return jd + DeltaT(jd)/86400
+}
@@ -0,0 +1,8 @@
+// Package astro is a small ephemeris for the Sun and the Moon, which provides the astronomical
+// calculations needed by Hijri calendars, like the time of new moon (conjunction), the apparent
+// position of the Sun and the Moon seen by an observer, and the time when they rise and set. Most
+// of the algorithms are taken from Jean Meeus' "Astronomical Algorithms" (2nd edition, 1998).
+//
+// Unless mentioned otherwise, every instant in this package uses Universal Time (UT), while the
+// calculations internally use Terrestrial Time (TT) which is offset by Delta T.
+package astro
@@ -0,0 +1,359 @@
+package astro
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// EclipseType is the type of an eclipse.
+type EclipseType int
+
+const (
+	// Partial eclipse only covers part of the eclipsed body.
+	Partial EclipseType = iota
+
+	// Annular solar eclipse leaves a ring of the Sun around the Moon.
+	Annular
+
+	// Total eclipse covers the whole eclipsed body.
+	Total
+
+	// Hybrid solar eclipse is annular in some places and total in the others.
+	Hybrid
+
+	// Penumbral lunar eclipse only dims the Moon with Earth's penumbra.
+	Penumbral
+)
+
+// String returns the English name of the eclipse type.
+func (t EclipseType) String() string {
+	switch t {
+	case Partial:
+		return "partial"
+	case Annular:
+		return "annular"
+	case Total:
+		return "total"
+	case Hybrid:
+		return "hybrid"
+	case Penumbral:
+		return "penumbral"
+	default:
+		return "unknown"
+	}
+}
+
+// Eclipse is a solar or lunar eclipse, as seen from the whole Earth.
+type Eclipse struct {
+	// Body is the eclipsed body, i.e. Sun for solar eclipse and Moon for lunar eclipse.
+	Body Body
+	Type EclipseType
+
+	// Maximum is the instant of greatest eclipse.
+	Maximum time.Time
+
+	// Gamma is the least distance from the axis of Moon's shadow (for solar eclipse) or the Moon
+	// center (for lunar eclipse) to the center of Earth, in units of Earth's equatorial radius.
+	Gamma float64
+
+	// Magnitude is the fraction of the eclipsed body's diameter that covered at the maximum. For
+	// solar eclipse it's only calculated for the partial one, while for lunar eclipse it's the
+	// umbral magnitude, or the penumbral magnitude for penumbral eclipse.
+	Magnitude float64
+
+	// Start and End is the beginning and the end of the main phase of lunar eclipse, i.e. the
+	// umbral phase or the penumbral phase for penumbral eclipse. TotalStart and TotalEnd is the
+	// totality for total lunar eclipse. They are zero for solar eclipse.
+	Start      time.Time
+	End        time.Time
+	TotalStart time.Time
+	TotalEnd   time.Time
+}
+
+// LocalEclipse is the circumstance of an eclipse seen from an observer.
+type LocalEclipse struct {
+	// Visible is true if any part of the eclipse can be seen, i.e. the eclipsed body is above the
+	// horizon during the eclipse.
+	Visible bool
+
+	// Start and End is the visible part of the eclipse.
+	Start time.Time
+	End   time.Time
+
+	// Maximum is the instant of greatest eclipse for the observer, while Altitude is the altitude
+	// of the eclipsed body at that instant.
+	Maximum  time.Time
+	Altitude float64
+
+	// Magnitude is the fraction of the Sun's diameter covered by the Moon seen by the observer. For
+	// lunar eclipse it's the same as the global magnitude.
+	Magnitude float64
+}
+
+// SolarEclipse returns the solar eclipse at the new moon of lunation k, or false if there is no
+// eclipse at that new moon. It uses the method from chapter 54 of Meeus, which accurate to a few
+// minutes for the instant of maximum eclipse.
+func SolarEclipse(k int64) (Eclipse, bool) {
+	eclipse, ok := eclipseElements(float64(k))
+	if !ok {
+		return Eclipse{}, false
+	}
+
+	// Find the eclipse type from gamma and the radius of the umbral cone
+	gamma, u := eclipse.Gamma, eclipse.Magnitude
+	absGamma := math.Abs(gamma)
+	eclipse.Body = Sun
+	eclipse.Magnitude = 0
+
+	switch {
+	case absGamma > 1.5433+u:
+		return Eclipse{}, false
+	case absGamma < 0.9972:
+		omega := 0.00464 * math.Sqrt(1-gamma*gamma)
+		switch {
+		case u < 0:
+			eclipse.Type = Total
+		case u > omega:
+			eclipse.Type = Annular
+		default:
+			eclipse.Type = Hybrid
+		}
+	case absGamma < 0.9972+math.Abs(u):
+		// Non-central eclipse, where the shadow axis misses Earth but the shadow cone touches it
+		eclipse.Type = Total
+		if u > 0 {
+			eclipse.Type = Annular
+		}
+	default:
+		eclipse.Type = Partial
+		eclipse.Magnitude = (1.5433 + u - absGamma) / (0.5461 + 2*u)
+	}
+
+	return eclipse.Eclipse, true
+}
+
+// LunarEclipse returns the lunar eclipse at the full moon of lunation k, or false if there is no
+// eclipse at that full moon. It uses the method from chapter 54 of Meeus.
+func LunarEclipse(k int64) (Eclipse, bool) {
+	eclipse, ok := eclipseElements(float64(k) + 0.5)
+	if !ok {
+		return Eclipse{}, false
+	}
+
+	gamma, u := eclipse.Gamma, eclipse.Magnitude
+	absGamma := math.Abs(gamma)
+	penumbralMagnitude := (1.5573 + u - absGamma) / 0.5450
+	umbralMagnitude := (1.0128 - u - absGamma) / 0.5450
+	if penumbralMagnitude <= 0 {
+		return Eclipse{}, false
+	}
+
+	eclipse.Body = Moon
+	eclipse.Magnitude = umbralMagnitude
+
+	// Semidurations of each phase in minutes
+	mp := eclipse.meanAnomaly
+	n := 0.5458 + 0.0400*cos(mp)
+	semiduration := func(radius float64) time.Duration {
+		return time.Duration(60 / n * math.Sqrt(radius*radius-gamma*gamma) * float64(time.Minute))
+	}
+
+	switch {
+	case umbralMagnitude <= 0:
+		eclipse.Type = Penumbral
+		eclipse.Magnitude = penumbralMagnitude
+		d := semiduration(1.5573 + u)
+		eclipse.Start, eclipse.End = eclipse.Maximum.Add(-d), eclipse.Maximum.Add(d)
+	case umbralMagnitude < 1:
+		eclipse.Type = Partial
+		d := semiduration(1.0128 - u)
+		eclipse.Start, eclipse.End = eclipse.Maximum.Add(-d), eclipse.Maximum.Add(d)
+	default:
+		eclipse.Type = Total
+		d := semiduration(1.0128 - u)
+		eclipse.Start, eclipse.End = eclipse.Maximum.Add(-d), eclipse.Maximum.Add(d)
+		d = semiduration(0.4678 - u)
+		eclipse.TotalStart, eclipse.TotalEnd = eclipse.Maximum.Add(-d), eclipse.Maximum.Add(d)
+	}
+
+	return eclipse.Eclipse, true
+}
+
+// Eclipses returns all solar and lunar eclipses which maximum happened between start and end,
+// sorted by time.
+func Eclipses(start, end time.Time) []Eclipse {
+	var eclipses []Eclipse
+	for k := Lunation(start) - 1; k <= Lunation(end); k++ {
+		if eclipse, ok := SolarEclipse(k); ok {
+			eclipses = append(eclipses, eclipse)
+		}
+
+		if eclipse, ok := LunarEclipse(k); ok {
+			eclipses = append(eclipses, eclipse)
+		}
+	}
+
+	// Remove the eclipses outside the range
+	filtered := eclipses[:0]
+	for _, eclipse := range eclipses {
+		if !eclipse.Maximum.Before(start) && eclipse.Maximum.Before(end) {
+			filtered = append(filtered, eclipse)
+		}
+	}
+
+	sort.Slice(filtered, func(a, b int) bool {
+		return filtered[a].Maximum.Before(filtered[b].Maximum)
+	})
+
+	return filtered
+}
+
+// Local returns the circumstance of the eclipse for the observer. For solar eclipse it searches
+// the instant when the Sun and the Moon are closest in the observer's sky, while for lunar eclipse
+// it checks whether the Moon is above the horizon during the main phase.
+func (e Eclipse) Local(obs Observer) LocalEclipse {
+	if e.Body == Moon {
+		return e.localLunar(obs)
+	}
+	return e.localSolar(obs)
+}
+
+func (e Eclipse) localLunar(obs Observer) LocalEclipse {
+	local := LocalEclipse{
+		Maximum:   e.Maximum,
+		Altitude:  Moon.Position(e.Maximum, obs).Altitude,
+		Magnitude: e.Magnitude,
+	}
+
+	for t := e.Start; !t.After(e.End); t = t.Add(time.Minute) {
+		pos := Moon.Position(t, obs)
+		if pos.Altitude < obs.horizonAltitude(pos) {
+			continue
+		}
+
+		if !local.Visible {
+			local.Visible = true
+			local.Start = t
+		}
+		local.End = t
+	}
+
+	return local
+}
+
+func (e Eclipse) localSolar(obs Observer) LocalEclipse {
+	var local LocalEclipse
+	for t := e.Maximum.Add(-4 * time.Hour); t.Before(e.Maximum.Add(4 * time.Hour)); t = t.Add(time.Minute) {
+		sun := Sun.Position(t, obs)
+		if sun.Altitude < obs.horizonAltitude(sun) {
+			continue
+		}
+
+		moon := Moon.Position(t, obs)
+		separation := AngularSeparation(
+			sun.TopocentricRightAscension, sun.TopocentricDeclination,
+			moon.TopocentricRightAscension, moon.TopocentricDeclination)
+		overlap := sun.Semidiameter + moon.Semidiameter - separation
+		if overlap <= 0 {
+			continue
+		}
+
+		if !local.Visible {
+			local.Visible = true
+			local.Start = t
+		}
+		local.End = t
+
+		if magnitude := overlap / (2 * sun.Semidiameter); magnitude > local.Magnitude {
+			local.Magnitude = magnitude
+			local.Maximum = t
+			local.Altitude = sun.Altitude
+		}
+	}
+
+	return local
+}
+
+// eclipseElements calculates the instant of maximum eclipse and gamma for new moon or full moon
+// at lunation k, using chapter 54 of Meeus. It returns false if the Moon is too far from its node.
+// The radius of umbral cone (u) is temporarily stored as magnitude.
+func eclipseElements(k float64) (eclipseWithAnomaly, bool) {
+	T := k / 1236.85
+	T2, T3, T4 := T*T, T*T*T, T*T*T*T
+
+	F := 160.7108 + 390.67050284*k - 0.0016118*T2 - 0.00000227*T3 + 0.000000011*T4
+	if math.Abs(sin(F)) > 0.36 {
+		return eclipseWithAnomaly{}, false
+	}
+
+	jde := 2451550.09766 + 29.530588861*k + 0.00015437*T2 - 0.000000150*T3 + 0.00000000073*T4
+	M := 2.5534 + 29.10535670*k - 0.0000014*T2 - 0.00000011*T3
+	Mp := 201.5643 + 385.81693528*k + 0.0107582*T2 + 0.00001238*T3 - 0.000000058*T4
+	Om := 124.7746 - 1.56375588*k + 0.0020672*T2 + 0.00000215*T3
+	E := 1 - 0.002516*T - 0.0000074*T2
+	F1 := F - 0.02665*sin(Om)
+	A1 := 299.77 + 0.107408*k - 0.009173*T2
+
+	// Instant of maximum eclipse
+	if k == math.Floor(k) {
+		jde += -0.4075*sin(Mp) + 0.1721*E*sin(M)
+	} else {
+		jde += -0.4065*sin(Mp) + 0.1727*E*sin(M)
+	}
+
+	jde += 0.0161*sin(2*Mp) -
+		0.0097*sin(2*F1) +
+		0.0073*E*sin(Mp-M) -
+		0.0050*E*sin(Mp+M) -
+		0.0023*sin(Mp-2*F1) +
+		0.0021*E*sin(2*M) +
+		0.0012*sin(Mp+2*F1) +
+		0.0006*E*sin(2*Mp+M) -
+		0.0004*sin(3*Mp) -
+		0.0003*E*sin(M+2*F1) +
+		0.0003*sin(A1) -
+		0.0002*E*sin(M-2*F1) -
+		0.0002*E*sin(2*Mp-M) -
+		0.0002*sin(Om)
+
+	// Gamma and the radius of the umbral cone
+	P := 0.2070*E*sin(M) +
+		0.0024*E*sin(2*M) -
+		0.0392*sin(Mp) +
+		0.0116*sin(2*Mp) -
+		0.0073*E*sin(Mp+M) +
+		0.0067*E*sin(Mp-M) +
+		0.0118*sin(2*F1)
+
+	Q := 5.2207 -
+		0.0048*E*cos(M) +
+		0.0020*E*cos(2*M) -
+		0.3299*cos(Mp) -
+		0.0060*E*cos(Mp+M) +
+		0.0041*E*cos(Mp-M)
+
+	W := math.Abs(cos(F1))
+	gamma := (P*cos(F1) + Q*sin(F1)) * (1 - 0.0048*W)
+	u := 0.0059 +
+		0.0046*E*cos(M) -
+		0.0182*cos(Mp) +
+		0.0004*cos(2*Mp) -
+		0.0005*cos(M+Mp)
+
+	return eclipseWithAnomaly{
+		Eclipse: Eclipse{
+			Maximum:   JulianDayToTime(ttToUT(jde)),
+			Gamma:     gamma,
+			Magnitude: u,
+		},
+		meanAnomaly: Mp,
+	}, true
+}
+
+// eclipseWithAnomaly is an eclipse with the Moon's mean anomaly, which needed to calculate the
+// duration of lunar eclipse.
+type eclipseWithAnomaly struct {
+	Eclipse
+	meanAnomaly float64
+}
@@ -0,0 +1,140 @@
+package astro_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri/astro"
+)
+
+func Test_Eclipse_Solar(t *testing.T) {
+	// Meeus example 54.a, 1993 May 21 at 14:20:59 TD, which is about 14:20 UT
+	eclipse, ok := astro.SolarEclipse(-82)
+	if !ok {
+		t.Fatalf("want eclipse in lunation -82\n")
+	}
+
+	expected := time.Date(1993, 5, 21, 14, 20, 0, 0, time.UTC)
+	if diff := eclipse.Maximum.Sub(expected); diff < -time.Minute || diff > time.Minute {
+		t.Errorf("want %s got %s\n", expected, eclipse.Maximum)
+	}
+
+	if eclipse.Type != astro.Partial {
+		t.Errorf("want %s got %s\n", astro.Partial, eclipse.Type)
+	}
+
+	if math.Abs(eclipse.Gamma-1.1348) > 0.0005 {
+		t.Errorf("want gamma 1.1348 got %f\n", eclipse.Gamma)
+	}
+
+	if math.Abs(eclipse.Magnitude-0.740) > 0.001 {
+		t.Errorf("want magnitude 0.740 got %f\n", eclipse.Magnitude)
+	}
+
+	// No eclipse on new moon far from the node
+	if _, ok := astro.SolarEclipse(-81); ok {
+		t.Errorf("want no eclipse in lunation -81\n")
+	}
+}
+
+func Test_Eclipse_Lunar(t *testing.T) {
+	// Meeus example 54.b, 1973 June 15 at 20:50:56 TD, which is about 20:50 UT
+	eclipse, ok := astro.LunarEclipse(-329)
+	if !ok {
+		t.Fatalf("want eclipse in lunation -329\n")
+	}
+
+	expected := time.Date(1973, 6, 15, 20, 50, 0, 0, time.UTC)
+	if diff := eclipse.Maximum.Sub(expected); diff < -time.Minute || diff > time.Minute {
+		t.Errorf("want %s got %s\n", expected, eclipse.Maximum)
+	}
+
+	if eclipse.Type != astro.Penumbral {
+		t.Errorf("want %s got %s\n", astro.Penumbral, eclipse.Type)
+	}
+
+	if math.Abs(eclipse.Magnitude-0.4625) > 0.001 {
+		t.Errorf("want magnitude 0.4625 got %f\n", eclipse.Magnitude)
+	}
+
+	// Meeus gives semiduration of 101.5 minutes
+	semiduration := eclipse.End.Sub(eclipse.Start) / 2
+	if diff := semiduration - 101*time.Minute; diff < 0 || diff > time.Minute {
+		t.Errorf("want semiduration 101.5m got %s\n", semiduration)
+	}
+}
+
+func Test_Eclipse_List(t *testing.T) {
+	// Eclipses in 2024 and 2025 published by NASA
+	tests := []struct {
+		Body    astro.Body
+		Type    astro.EclipseType
+		Maximum time.Time
+	}{
+		{astro.Moon, astro.Penumbral, time.Date(2024, 3, 25, 7, 13, 0, 0, time.UTC)},
+		{astro.Sun, astro.Total, time.Date(2024, 4, 8, 18, 17, 0, 0, time.UTC)},
+		{astro.Moon, astro.Partial, time.Date(2024, 9, 18, 2, 44, 0, 0, time.UTC)},
+		{astro.Sun, astro.Annular, time.Date(2024, 10, 2, 18, 45, 0, 0, time.UTC)},
+		{astro.Moon, astro.Total, time.Date(2025, 3, 14, 6, 59, 0, 0, time.UTC)},
+		{astro.Sun, astro.Partial, time.Date(2025, 3, 29, 10, 47, 0, 0, time.UTC)},
+		{astro.Moon, astro.Total, time.Date(2025, 9, 7, 18, 11, 0, 0, time.UTC)},
+		{astro.Sun, astro.Partial, time.Date(2025, 9, 21, 19, 41, 0, 0, time.UTC)},
+	}
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	eclipses := astro.Eclipses(start, end)
+	if len(eclipses) != len(tests) {
+		t.Fatalf("want %d eclipses got %d\n", len(tests), len(eclipses))
+	}
+
+	for i, test := range tests {
+		eclipse := eclipses[i]
+		if eclipse.Body != test.Body || eclipse.Type != test.Type {
+			t.Errorf("%d: want %s %s got %s %s\n", i, test.Type, test.Body, eclipse.Type, eclipse.Body)
+		}
+
+		if diff := eclipse.Maximum.Sub(test.Maximum); diff < -2*time.Minute || diff > 2*time.Minute {
+			t.Errorf("%d: want %s got %s\n", i, test.Maximum, eclipse.Maximum)
+		}
+	}
+}
+
+func Test_Eclipse_Local(t *testing.T) {
+	jakarta := astro.Observer{Latitude: -6.175, Longitude: 106.8275}
+	newYork := astro.Observer{Latitude: 40.7128, Longitude: -74.006}
+	dallas := astro.Observer{Latitude: 32.7767, Longitude: -96.797}
+
+	// Total solar eclipse on 8 April 2024 is total in Dallas, partial in New York and not visible
+	// in Jakarta since it happened at night.
+	solar, _ := astro.SolarEclipse(300)
+	if local := solar.Local(jakarta); local.Visible {
+		t.Errorf("solar eclipse: want not visible in Jakarta\n")
+	}
+
+	local := solar.Local(newYork)
+	if !local.Visible || math.Abs(local.Magnitude-0.90) > 0.02 {
+		t.Errorf("solar eclipse: want magnitude 0.90 in New York got %f\n", local.Magnitude)
+	}
+
+	local = solar.Local(dallas)
+	if !local.Visible || local.Magnitude < 1 {
+		t.Errorf("solar eclipse: want total in Dallas got %f\n", local.Magnitude)
+	}
+
+	expected := time.Date(2024, 4, 8, 18, 42, 0, 0, time.UTC)
+	if diff := local.Maximum.Sub(expected); diff < -2*time.Minute || diff > 2*time.Minute {
+		t.Errorf("solar eclipse: want maximum %s got %s\n", expected, local.Maximum)
+	}
+
+	// Total lunar eclipse on 7 September 2025 is visible in Jakarta, but not in New York
+	lunar, _ := astro.LunarEclipse(317)
+	if local := lunar.Local(jakarta); !local.Visible || local.Altitude < 0 {
+		t.Errorf("lunar eclipse: want visible in Jakarta\n")
+	}
+
+	if local := lunar.Local(newYork); local.Visible {
+		t.Errorf("lunar eclipse: want not visible in New York\n")
+	}
+}
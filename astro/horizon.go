@@ -0,0 +1,95 @@
+package astro
+
+import "math"
+
+// standardRefraction is the conventional atmospheric refraction at the horizon in degrees.
+const standardRefraction = 34.0 / 60
+
+// Refraction is the model of atmospheric refraction.
+type Refraction int
+
+const (
+	// StandardRefraction uses 34 arcminutes of refraction at the horizon, and the formula by
+	// Sæmundsson for the apparent altitude above it.
+	StandardRefraction Refraction = iota
+
+	// NoRefraction ignores the atmosphere, i.e. the body is seen at its geometric position.
+	NoRefraction
+)
+
+// Limb is the part of the body's disk that used to decide rise, set and altitude.
+type Limb int
+
+const (
+	// UpperLimb is the top edge of the disk, so the body rises when it starts to appear and sets
+	// when it completely disappears.
+	UpperLimb Limb = iota
+
+	// CenterLimb is the center of the disk.
+	CenterLimb
+
+	// LowerLimb is the bottom edge of the disk.
+	LowerLimb
+)
+
+// Horizon is the model of horizon seen by the observer.
+type Horizon int
+
+const (
+	// StandardHorizon is the astronomical horizon, which altitude is zero no matter the elevation
+	// of the observer.
+	StandardHorizon Horizon = iota
+
+	// ActualHorizon is the sea horizon seen from the observer's elevation, which is lower than
+	// the standard horizon by the dip angle.
+	ActualHorizon
+)
+
+// Dip returns the angle in degrees between the standard horizon and the actual horizon seen by
+// the observer, i.e. 1.76 arcminutes times the square root of the elevation in meters. It's zero
+// for standard horizon.
+func (obs Observer) Dip() float64 {
+	if obs.Horizon != ActualHorizon || obs.Elevation <= 0 {
+		return 0
+	}
+	return 1.76 / 60 * math.Sqrt(obs.Elevation)
+}
+
+// ApparentAltitude returns the altitude of the body seen by observer using its conventions, i.e.
+// the altitude of the observer's limb, raised by the refraction and measured from its horizon.
+func (obs Observer) ApparentAltitude(pos Position) float64 {
+	altitude := pos.Altitude + obs.limbOffset(pos)
+	return altitude + obs.refraction(altitude) + obs.Dip()
+}
+
+// horizonAltitude returns the geometric altitude of the body center when it rises or sets for the
+// observer.
+func (obs Observer) horizonAltitude(pos Position) float64 {
+	var refraction float64
+	if obs.Refraction == StandardRefraction {
+		refraction = standardRefraction
+	}
+
+	return -refraction - obs.Dip() - obs.limbOffset(pos)
+}
+
+// limbOffset returns the altitude of the observer's limb relative to the body center.
+func (obs Observer) limbOffset(pos Position) float64 {
+	switch obs.Limb {
+	case UpperLimb:
+		return pos.Semidiameter
+	case LowerLimb:
+		return -pos.Semidiameter
+	default:
+		return 0
+	}
+}
+
+// refraction returns the atmospheric refraction in degrees for the geometric altitude, using
+// formula 16.4 of Meeus. Far below the horizon the body can't be seen anyway, so it's zero.
+func (obs Observer) refraction(altitude float64) float64 {
+	if obs.Refraction == NoRefraction || altitude < -2 {
+		return 0
+	}
+	return 1.02 / tan(altitude+10.3/(altitude+5.11)) / 60
+}
@@ -0,0 +1,77 @@
+package astro_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri/astro"
+)
+
+func Test_Horizon_Dip(t *testing.T) {
+	obs := astro.Observer{Elevation: 100}
+	if dip := obs.Dip(); dip != 0 {
+		t.Errorf("standard horizon: want no dip got %f\n", dip)
+	}
+
+	obs.Horizon = astro.ActualHorizon
+	if dip := obs.Dip() * 60; math.Abs(dip-17.6) > 0.01 {
+		t.Errorf("actual horizon: want dip 17.6' got %f'\n", dip)
+	}
+}
+
+func Test_Horizon_ApparentAltitude(t *testing.T) {
+	// Meeus example 16.a, body seen at 0.5° has refraction of 28.75 arcminutes
+	pos := astro.Position{Altitude: 0.5 - 28.75/60, Semidiameter: 0.25}
+	obs := astro.Observer{Limb: astro.CenterLimb}
+	if result := obs.ApparentAltitude(pos); math.Abs(result-0.5) > 0.005 {
+		t.Errorf("center limb: want 0.5 got %f\n", result)
+	}
+
+	obs = astro.Observer{Refraction: astro.NoRefraction, Limb: astro.LowerLimb}
+	if result := obs.ApparentAltitude(pos); math.Abs(result-(pos.Altitude-0.25)) > 1e-9 {
+		t.Errorf("airless lower limb: want %f got %f\n", pos.Altitude-0.25, result)
+	}
+
+	obs = astro.Observer{Refraction: astro.NoRefraction, Horizon: astro.ActualHorizon, Elevation: 100}
+	if result := obs.ApparentAltitude(pos); math.Abs(result-(pos.Altitude+0.25+obs.Dip())) > 1e-9 {
+		t.Errorf("actual horizon: want %f got %f\n", pos.Altitude+0.25+obs.Dip(), result)
+	}
+}
+
+func Test_Horizon_Sunset(t *testing.T) {
+	// Sunset in Jakarta on 20 April 2023 is at 17:50 WIB using the default conventions
+	location := time.FixedZone("WIB", 7*60*60)
+	date := time.Date(2023, 4, 20, 0, 0, 0, 0, location)
+	jakarta := astro.Observer{Latitude: -6.175, Longitude: 106.8275, Elevation: 8}
+	sunset := astro.Sun.Set(date, jakarta)
+
+	// At sunset the upper limb is seen on the horizon
+	if result := jakarta.ApparentAltitude(astro.Sun.Position(sunset, jakarta)); math.Abs(result) > 0.01 {
+		t.Errorf("default: want apparent altitude 0 got %f\n", result)
+	}
+
+	tests := []struct {
+		Name     string
+		Observer astro.Observer
+		Min, Max time.Duration
+	}{
+		{"center limb", astro.Observer{Limb: astro.CenterLimb}, -70 * time.Second, -60 * time.Second},
+		{"lower limb", astro.Observer{Limb: astro.LowerLimb}, -140 * time.Second, -125 * time.Second},
+		{"airless", astro.Observer{Refraction: astro.NoRefraction}, -150 * time.Second, -130 * time.Second},
+		{"actual horizon", astro.Observer{Horizon: astro.ActualHorizon, Elevation: 1000}, 220 * time.Second, 240 * time.Second},
+	}
+
+	for _, test := range tests {
+		obs := test.Observer
+		obs.Latitude, obs.Longitude = jakarta.Latitude, jakarta.Longitude
+		if obs.Elevation == 0 {
+			obs.Elevation = jakarta.Elevation
+		}
+
+		diff := astro.Sun.Set(date, obs).Sub(sunset)
+		if diff < test.Min || diff > test.Max {
+			t.Errorf("%s: want between %s and %s got %s\n", test.Name, test.Min, test.Max, diff)
+		}
+	}
+}
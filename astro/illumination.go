@@ -0,0 +1,24 @@
+package astro
+
+import "time"
+
+// PhaseAngle returns the phase angle of the Moon at the instant in degrees, i.e. the angle between
+// the Sun and Earth seen from the Moon. It's 180° at new moon and 0° at full moon.
+func PhaseAngle(t time.Time) float64 {
+	return moonPhaseAngle(JulianDay(t))
+}
+
+// Illumination returns the illuminated fraction of the Moon's disk at the instant, from 0 at new
+// moon to 1 at full moon.
+func Illumination(t time.Time) float64 {
+	return (1 + cos(moonPhaseAngle(JulianDay(t)))) / 2
+}
+
+// moonPhaseAngle calculates the phase angle using chapter 48 of Meeus.
+func moonPhaseAngle(jd float64) float64 {
+	sun := Sun.position(jd, Observer{})
+	moon := Moon.position(jd, Observer{})
+
+	elongation := AngularSeparation(sun.RightAscension, sun.Declination, moon.RightAscension, moon.Declination)
+	return atan2(sun.Distance*sin(elongation), moon.Distance-sun.Distance*cos(elongation))
+}
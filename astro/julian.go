@@ -0,0 +1,58 @@
+package astro
+
+import (
+	"math"
+	"time"
+)
+
+// j2000 is the Julian Day of epoch J2000.0, i.e. 1 January 2000 at 12:00 TT.
+const j2000 = 2451545.0
+
+// unixEpoch is the Julian Day of Unix epoch, i.e. 1 January 1970 at 00:00 UTC.
+const unixEpoch = 2440587.5
+
+// JulianDay converts Golang time into Julian Day. Unlike Julian Days that used by the Hijri
+// package, this one always treats the date as proleptic Gregorian like Golang does, so it
+// represents the same instant as the time.
+func JulianDay(t time.Time) float64 {
+	seconds := float64(t.Unix()) + float64(t.Nanosecond())/1e9
+	return unixEpoch + seconds/86400
+}
+
+// JulianDayToTime converts Julian Day into Golang time in UTC. Since Julian Day is stored as
+// floating point, the time is rounded to the nearest millisecond.
+func JulianDayToTime(jd float64) time.Time {
+	milliseconds := math.Round((jd - unixEpoch) * 86400000)
+	seconds := math.Floor(milliseconds / 1000)
+	nanoseconds := (milliseconds - seconds*1000) * 1e6
+	return time.Unix(int64(seconds), int64(nanoseconds)).UTC()
+}
+
+// julianCentury returns the number of Julian centuries since J2000.0.
+func julianCentury(jd float64) float64 {
+	return (jd - j2000) / 36525
+}
+
+// decimalYear returns the year of the Julian Day with its fraction, e.g. 2000.5 for the middle
+// of year 2000.
+func decimalYear(jd float64) float64 {
+	t := JulianDayToTime(jd)
+	return float64(t.Year()) + (float64(t.Month())-0.5)/12
+}
+
+func sin(deg float64) float64 { return math.Sin(deg * math.Pi / 180) }
+func cos(deg float64) float64 { return math.Cos(deg * math.Pi / 180) }
+func tan(deg float64) float64 { return math.Tan(deg * math.Pi / 180) }
+
+func asin(x float64) float64     { return math.Asin(x) * 180 / math.Pi }
+func acos(x float64) float64     { return math.Acos(x) * 180 / math.Pi }
+func atan2(y, x float64) float64 { return math.Atan2(y, x) * 180 / math.Pi }
+
+// normalizeDegrees converts angle into range [0, 360).
+func normalizeDegrees(deg float64) float64 {
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return deg
+}
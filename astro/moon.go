@@ -0,0 +1,206 @@
+package astro
+
+// moonEcliptic calculates the apparent geocentric ecliptic longitude and latitude of the Moon in
+// degrees, and its distance in kilometers, using the algorithm from chapter 47 of Meeus which
+// accurate to 10" in longitude and 4" in latitude.
+func moonEcliptic(jde float64) (longitude, latitude, distance float64) {
+	T := julianCentury(jde)
+	T2, T3, T4 := T*T, T*T*T, T*T*T*T
+
+	// Moon's mean longitude, mean elongation, Sun's mean anomaly, Moon's mean anomaly and Moon's
+	// argument of latitude.
+	Lp := 218.3164477 + 481267.88123421*T - 0.0015786*T2 + T3/538841 - T4/65194000
+	D := 297.8501921 + 445267.1114034*T - 0.0018819*T2 + T3/545868 - T4/113065000
+	M := 357.5291092 + 35999.0502909*T - 0.0001536*T2 + T3/24490000
+	Mp := 134.9633964 + 477198.8675055*T + 0.0087414*T2 + T3/69699 - T4/14712000
+	F := 93.2720950 + 483202.0175233*T - 0.0036539*T2 - T3/3526000 + T4/863310000
+
+	// Further arguments and eccentricity of Earth's orbit
+	A1 := 119.75 + 131.849*T
+	A2 := 53.09 + 479264.290*T
+	A3 := 313.45 + 481266.484*T
+	E := 1 - 0.002516*T - 0.0000074*T2
+
+	Lp, D, M = normalizeDegrees(Lp), normalizeDegrees(D), normalizeDegrees(M)
+	Mp, F = normalizeDegrees(Mp), normalizeDegrees(F)
+
+	// Sum the periodic terms for longitude and distance
+	var sumL, sumR float64
+	for _, term := range moonLongitudeTerms {
+		arg := term.D*D + term.M*M + term.Mp*Mp + term.F*F
+		factor := eccentricityFactor(E, term.M)
+		sumL += term.L * factor * sin(arg)
+		sumR += term.R * factor * cos(arg)
+	}
+
+	// Sum the periodic terms for latitude
+	var sumB float64
+	for _, term := range moonLatitudeTerms {
+		arg := term.D*D + term.M*M + term.Mp*Mp + term.F*F
+		sumB += term.B * eccentricityFactor(E, term.M) * sin(arg)
+	}
+
+	// Additional terms because of Venus, Jupiter and the flattening of Earth
+	sumL += 3958*sin(A1) + 1962*sin(Lp-F) + 318*sin(A2)
+	sumB += -2235*sin(Lp) + 382*sin(A3) + 175*sin(A1-F) + 175*sin(A1+F) +
+		127*sin(Lp-Mp) - 115*sin(Lp+Mp)
+
+	// Apparent longitude, corrected for nutation
+	deltaPsi, _ := nutation(jde)
+	longitude = normalizeDegrees(Lp + sumL/1000000 + deltaPsi)
+	latitude = sumB / 1000000
+	distance = 385000.56 + sumR/1000
+	return
+}
+
+// eccentricityFactor returns the factor for terms that contain Sun's mean anomaly, since their
+// amplitude decreases along with the eccentricity of Earth's orbit.
+func eccentricityFactor(E, m float64) float64 {
+	switch m {
+	case 1, -1:
+		return E
+	case 2, -2:
+		return E * E
+	default:
+		return 1
+	}
+}
+
+type moonTerm struct {
+	D, M, Mp, F float64
+	L, R        float64
+}
+
+type moonLatitudeTerm struct {
+	D, M, Mp, F float64
+	B           float64
+}
+
+// moonLongitudeTerms is periodic terms for longitude (in 0.000001°) and distance (in 0.001 km),
+// taken from table 47.A of Meeus.
+var moonLongitudeTerms = []moonTerm{
+	{0, 0, 1, 0, 6288774, -20905355},
+	{2, 0, -1, 0, 1274027, -3699111},
+	{2, 0, 0, 0, 658314, -2955968},
+	{0, 0, 2, 0, 213618, -569925},
+	{0, 1, 0, 0, -185116, 48888},
+	{0, 0, 0, 2, -114332, -3149},
+	{2, 0, -2, 0, 58793, 246158},
+	{2, -1, -1, 0, 57066, -152138},
+	{2, 0, 1, 0, 53322, -170733},
+	{2, -1, 0, 0, 45758, -204586},
+	{0, 1, -1, 0, -40923, -129620},
+	{1, 0, 0, 0, -34720, 108743},
+	{0, 1, 1, 0, -30383, 104755},
+	{2, 0, 0, -2, 15327, 10321},
+	{0, 0, 1, 2, -12528, 0},
+	{0, 0, 1, -2, 10980, 79661},
+	{4, 0, -1, 0, 10675, -34782},
+	{0, 0, 3, 0, 10034, -23210},
+	{4, 0, -2, 0, 8548, -21636},
+	{2, 1, -1, 0, -7888, 24208},
+	{2, 1, 0, 0, -6766, 30824},
+	{1, 0, -1, 0, -5163, -8379},
+	{1, 1, 0, 0, 4987, -16675},
+	{2, -1, 1, 0, 4036, -12831},
+	{2, 0, 2, 0, 3994, -10445},
+	{4, 0, 0, 0, 3861, -11650},
+	{2, 0, -3, 0, 3665, 14403},
+	{0, 1, -2, 0, -2689, -7003},
+	{2, 0, -1, 2, -2602, 0},
+	{2, -1, -2, 0, 2390, 10056},
+	{1, 0, 1, 0, -2348, 6322},
+	{2, -2, 0, 0, 2236, -9884},
+	{0, 1, 2, 0, -2120, 5751},
+	{0, 2, 0, 0, -2069, 0},
+	{2, -2, -1, 0, 2048, -4950},
+	{2, 0, 1, -2, -1773, 4130},
+	{2, 0, 0, 2, -1595, 0},
+	{4, -1, -1, 0, 1215, -3958},
+	{0, 0, 2, 2, -1110, 0},
+	{3, 0, -1, 0, -892, 3258},
+	{2, 1, 1, 0, -810, 2616},
+	{4, -1, -2, 0, 759, -1897},
+	{0, 2, -1, 0, -713, -2117},
+	{2, 2, -1, 0, -700, 2354},
+	{2, 1, -2, 0, 691, 0},
+	{2, -1, 0, -2, 596, 0},
+	{4, 0, 1, 0, 549, -1423},
+	{0, 0, 4, 0, 537, -1117},
+	{4, -1, 0, 0, 520, -1571},
+	{1, 0, -2, 0, -487, -1739},
+	{2, 1, 0, -2, -399, 0},
+	{0, 0, 2, -2, -381, -4421},
+	{1, 1, 1, 0, 351, 0},
+	{3, 0, -2, 0, -340, 0},
+	{4, 0, -3, 0, 330, 0},
+	{2, -1, 2, 0, 327, 0},
+	{0, 2, 1, 0, -323, 1165},
+	{1, 1, -1, 0, 299, 0},
+	{2, 0, 3, 0, 294, 0},
+	{2, 0, -1, -2, 0, 8752},
+}
+
+// moonLatitudeTerms is periodic terms for latitude (in 0.000001°), taken from table 47.B of Meeus.
+var moonLatitudeTerms = []moonLatitudeTerm{
+	{0, 0, 0, 1, 5128122},
+	{0, 0, 1, 1, 280602},
+	{0, 0, 1, -1, 277693},
+	{2, 0, 0, -1, 173237},
+	{2, 0, -1, 1, 55413},
+	{2, 0, -1, -1, 46271},
+	{2, 0, 0, 1, 32573},
+	{0, 0, 2, 1, 17198},
+	{2, 0, 1, -1, 9266},
+	{0, 0, 2, -1, 8822},
+	{2, -1, 0, -1, 8216},
+	{2, 0, -2, -1, 4324},
+	{2, 0, 1, 1, 4200},
+	{2, 1, 0, -1, -3359},
+	{2, -1, -1, 1, 2463},
+	{2, -1, 0, 1, 2211},
+	{2, -1, -1, -1, 2065},
+	{0, 1, -1, -1, -1870},
+	{4, 0, -1, -1, 1828},
+	{0, 1, 0, 1, -1794},
+	{0, 0, 0, 3, -1749},
+	{0, 1, -1, 1, -1565},
+	{1, 0, 0, 1, -1491},
+	{0, 1, 1, 1, -1475},
+	{0, 1, 1, -1, -1410},
+	{0, 1, 0, -1, -1344},
+	{1, 0, 0, -1, -1335},
+	{0, 0, 3, 1, 1107},
+	{4, 0, 0, -1, 1021},
+	{4, 0, -1, 1, 833},
+	{0, 0, 1, -3, 777},
+	{4, 0, -2, 1, 671},
+	{2, 0, 0, -3, 607},
+	{2, 0, 2, -1, 596},
+	{2, -1, 1, -1, 491},
+	{2, 0, -2, 1, -451},
+	{0, 0, 3, -1, 439},
+	{2, 0, 2, 1, 422},
+	{2, 0, -3, -1, 421},
+	{2, 1, -1, 1, -366},
+	{2, 1, 0, 1, -351},
+	{4, 0, 0, 1, 331},
+	{2, -1, 1, 1, 315},
+	{2, -2, 0, -1, 302},
+	{0, 0, 1, 3, -283},
+	{2, 1, 1, -1, -229},
+	{1, 1, 0, -1, 223},
+	{1, 1, 0, 1, 223},
+	{0, 1, -2, -1, -220},
+	{2, 1, -1, -1, -220},
+	{1, 0, 1, 1, -185},
+	{2, -1, -2, -1, 181},
+	{0, 1, 2, 1, -177},
+	{4, 0, -2, -1, 176},
+	{4, -1, -1, -1, 166},
+	{1, 0, 1, -1, -164},
+	{4, 0, 1, -1, 132},
+	{1, 0, -1, -1, -119},
+	{4, -1, 0, -1, 115},
+	{2, -2, 0, 1, 107},
+}
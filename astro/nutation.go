@@ -0,0 +1,24 @@
+package astro
+
+// nutation calculates nutation in longitude and in obliquity in degrees, using the simplified
+// terms from chapter 22 of Meeus which accurate to 0.5" and 0.1" respectively.
+func nutation(jde float64) (deltaPsi, deltaEpsilon float64) {
+	T := julianCentury(jde)
+
+	// Longitude of ascending node of Moon's orbit, and mean longitude of the Sun and the Moon
+	Om := 125.04452 - 1934.136261*T + 0.0020708*T*T + T*T*T/450000
+	L := 280.4665 + 36000.7698*T
+	Lp := 218.3165 + 481267.8813*T
+
+	deltaPsi = -17.20*sin(Om) - 1.32*sin(2*L) - 0.23*sin(2*Lp) + 0.21*sin(2*Om)
+	deltaEpsilon = 9.20*cos(Om) + 0.57*cos(2*L) + 0.10*cos(2*Lp) - 0.09*cos(2*Om)
+	return deltaPsi / 3600, deltaEpsilon / 3600
+}
+
+// meanObliquity calculates the mean obliquity of the ecliptic in degrees, using equation 22.2
+// of Meeus.
+func meanObliquity(jde float64) float64 {
+	T := julianCentury(jde)
+	seconds := 21.448 - 46.8150*T - 0.00059*T*T + 0.001813*T*T*T
+	return 23 + 26.0/60 + seconds/3600
+}
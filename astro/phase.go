@@ -0,0 +1,177 @@
+package astro
+
+import (
+	"math"
+	"time"
+)
+
+// meanLunation is the average length of a synodic month in days.
+const meanLunation = 29.530588861
+
+// NewMoon returns the instant of new moon (conjunction of the Sun and the Moon) for lunation k,
+// where lunation 0 is the new moon on 6 January 2000.
+func NewMoon(k int64) time.Time {
+	return JulianDayToTime(ttToUT(moonPhaseJDE(float64(k))))
+}
+
+// FirstQuarter returns the instant of the first quarter moon in lunation k.
+func FirstQuarter(k int64) time.Time {
+	return JulianDayToTime(ttToUT(moonPhaseJDE(float64(k) + 0.25)))
+}
+
+// FullMoon returns the instant of full moon in lunation k.
+func FullMoon(k int64) time.Time {
+	return JulianDayToTime(ttToUT(moonPhaseJDE(float64(k) + 0.5)))
+}
+
+// LastQuarter returns the instant of the last quarter moon in lunation k.
+func LastQuarter(k int64) time.Time {
+	return JulianDayToTime(ttToUT(moonPhaseJDE(float64(k) + 0.75)))
+}
+
+// Lunation returns the number of lunation that contains the specified instant, i.e. the lunation
+// of the last new moon that happened before or at the instant.
+func Lunation(t time.Time) int64 {
+	jde := utToTT(JulianDay(t))
+	k := int64(math.Floor((jde - 2451550.09766) / meanLunation))
+
+	// The estimation might be off by one because of the periodic terms
+	for moonPhaseJDE(float64(k)) > jde {
+		k--
+	}
+
+	for moonPhaseJDE(float64(k+1)) <= jde {
+		k++
+	}
+
+	return k
+}
+
+// moonPhaseJDE calculates Julian Ephemeris Day of the moon phase, using algorithm from chapter 49
+// of Meeus. The fraction of k decides the phase: .0 for new moon, .25 for first quarter, .5 for
+// full moon and .75 for last quarter.
+func moonPhaseJDE(k float64) float64 {
+	T := k / 1236.85
+	T2, T3, T4 := T*T, T*T*T, T*T*T*T
+
+	// Mean phase
+	jde := 2451550.09766 + 29.530588861*k + 0.00015437*T2 - 0.000000150*T3 + 0.00000000073*T4
+
+	// Eccentricity of Earth's orbit, Sun's and Moon's mean anomaly, Moon's argument of latitude and
+	// longitude of the ascending node of lunar orbit.
+	E := 1 - 0.002516*T - 0.0000074*T2
+	M := 2.5534 + 29.10535670*k - 0.0000014*T2 - 0.00000011*T3
+	Mp := 201.5643 + 385.81693528*k + 0.0107582*T2 + 0.00001238*T3 - 0.000000058*T4
+	F := 160.7108 + 390.67050284*k - 0.0016118*T2 - 0.00000227*T3 + 0.000000011*T4
+	Om := 124.7746 - 1.56375588*k + 0.0020672*T2 + 0.00000215*T3
+
+	var correction float64
+	switch phase := k - math.Floor(k); {
+	case phase < 0.1:
+		correction = -0.40720*sin(Mp) +
+			0.17241*E*sin(M) +
+			0.01608*sin(2*Mp) +
+			0.01039*sin(2*F) +
+			0.00739*E*sin(Mp-M) -
+			0.00514*E*sin(Mp+M) +
+			0.00208*E*E*sin(2*M) -
+			0.00111*sin(Mp-2*F) -
+			0.00057*sin(Mp+2*F) +
+			0.00056*E*sin(2*Mp+M) -
+			0.00042*sin(3*Mp) +
+			0.00042*E*sin(M+2*F) +
+			0.00038*E*sin(M-2*F) -
+			0.00024*E*sin(2*Mp-M) -
+			0.00017*sin(Om) -
+			0.00007*sin(Mp+2*M) +
+			0.00004*sin(2*Mp-2*F) +
+			0.00004*sin(3*M) +
+			0.00003*sin(Mp+M-2*F) +
+			0.00003*sin(2*Mp+2*F) -
+			0.00003*sin(Mp+M+2*F) +
+			0.00003*sin(Mp-M+2*F) -
+			0.00002*sin(Mp-M-2*F) -
+			0.00002*sin(3*Mp+M) +
+			0.00002*sin(4*Mp)
+
+	case phase > 0.4 && phase < 0.6:
+		correction = -0.40614*sin(Mp) +
+			0.17302*E*sin(M) +
+			0.01614*sin(2*Mp) +
+			0.01043*sin(2*F) +
+			0.00734*E*sin(Mp-M) -
+			0.00515*E*sin(Mp+M) +
+			0.00209*E*E*sin(2*M) -
+			0.00111*sin(Mp-2*F) -
+			0.00057*sin(Mp+2*F) +
+			0.00056*E*sin(2*Mp+M) -
+			0.00042*sin(3*Mp) +
+			0.00042*E*sin(M+2*F) +
+			0.00038*E*sin(M-2*F) -
+			0.00024*E*sin(2*Mp-M) -
+			0.00017*sin(Om) -
+			0.00007*sin(Mp+2*M) +
+			0.00004*sin(2*Mp-2*F) +
+			0.00004*sin(3*M) +
+			0.00003*sin(Mp+M-2*F) +
+			0.00003*sin(2*Mp+2*F) -
+			0.00003*sin(Mp+M+2*F) +
+			0.00003*sin(Mp-M+2*F) -
+			0.00002*sin(Mp-M-2*F) -
+			0.00002*sin(3*Mp+M) +
+			0.00002*sin(4*Mp)
+
+	default:
+		correction = -0.62801*sin(Mp) +
+			0.17172*E*sin(M) -
+			0.01183*E*sin(Mp+M) +
+			0.00862*sin(2*Mp) +
+			0.00804*sin(2*F) +
+			0.00454*E*sin(Mp-M) +
+			0.00204*E*E*sin(2*M) -
+			0.00180*sin(Mp-2*F) -
+			0.00070*sin(Mp+2*F) -
+			0.00040*sin(3*Mp) -
+			0.00034*E*sin(2*Mp-M) +
+			0.00032*E*sin(M+2*F) +
+			0.00032*E*sin(M-2*F) -
+			0.00028*E*E*sin(Mp+2*M) +
+			0.00027*E*sin(2*Mp+M) -
+			0.00017*sin(Om) -
+			0.00005*sin(Mp-M-2*F) +
+			0.00004*sin(2*Mp+2*F) -
+			0.00004*sin(Mp+M+2*F) +
+			0.00004*sin(Mp-2*M) +
+			0.00003*sin(Mp+M-2*F) +
+			0.00003*sin(3*M) +
+			0.00002*sin(2*Mp-2*F) +
+			0.00002*sin(Mp-M+2*F) -
+			0.00002*sin(3*Mp+M)
+
+		W := 0.00306 - 0.00038*E*cos(M) + 0.00026*cos(Mp) -
+			0.00002*cos(Mp-M) + 0.00002*cos(Mp+M) + 0.00002*cos(2*F)
+		if phase < 0.5 {
+			correction += W
+		} else {
+			correction -= W
+		}
+	}
+
+	// Additional corrections for all phases
+	additional := 0.000325*sin(299.77+0.107408*k-0.009173*T2) +
+		0.000165*sin(251.88+0.016321*k) +
+		0.000164*sin(251.83+26.651886*k) +
+		0.000126*sin(349.42+36.412478*k) +
+		0.000110*sin(84.66+18.206239*k) +
+		0.000062*sin(141.74+53.303771*k) +
+		0.000060*sin(207.14+2.453732*k) +
+		0.000056*sin(154.84+7.306860*k) +
+		0.000047*sin(34.52+27.261239*k) +
+		0.000042*sin(207.19+0.121824*k) +
+		0.000040*sin(291.34+1.844379*k) +
+		0.000037*sin(161.72+24.198154*k) +
+		0.000035*sin(239.56+25.513099*k) +
+		0.000023*sin(331.55+3.592518*k)
+
+	return jde + correction + additional
+}
@@ -0,0 +1,98 @@
+package astro_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri/astro"
+)
+
+func Test_Phase_NewMoon(t *testing.T) {
+	// Meeus example 49.a and new moons published by USNO
+	tests := []struct {
+		K        int64
+		Expected time.Time
+	}{
+		{-283, time.Date(1977, 2, 18, 3, 37, 0, 0, time.UTC)},
+		{0, time.Date(2000, 1, 6, 18, 14, 0, 0, time.UTC)},
+		{287, time.Date(2023, 3, 21, 17, 23, 0, 0, time.UTC)},
+		{300, time.Date(2024, 4, 8, 18, 21, 0, 0, time.UTC)},
+		{315, time.Date(2025, 6, 25, 10, 31, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		result := astro.NewMoon(test.K)
+		if diff := result.Sub(test.Expected); diff < -time.Minute || diff > time.Minute {
+			t.Errorf("%d: want %s got %s\n", test.K, test.Expected, result)
+		}
+
+		if k := astro.Lunation(result.Add(time.Hour)); k != test.K {
+			t.Errorf("%d: got lunation %d\n", test.K, k)
+		}
+
+		if k := astro.Lunation(result.Add(-time.Hour)); k != test.K-1 {
+			t.Errorf("%d: got lunation %d before new moon\n", test.K, k)
+		}
+	}
+}
+
+func Test_Phase_Quarters(t *testing.T) {
+	// Meeus example 49.b and phases published by USNO
+	tests := []struct {
+		Phase    func(int64) time.Time
+		K        int64
+		Expected time.Time
+	}{
+		{astro.LastQuarter, 544, time.Date(2044, 1, 21, 23, 46, 0, 0, time.UTC)},
+		{astro.FullMoon, 309, time.Date(2025, 1, 13, 22, 27, 0, 0, time.UTC)},
+		{astro.FullMoon, 311, time.Date(2025, 3, 14, 6, 55, 0, 0, time.UTC)},
+		{astro.FirstQuarter, 309, time.Date(2025, 1, 6, 23, 56, 0, 0, time.UTC)},
+		{astro.LastQuarter, 309, time.Date(2025, 1, 21, 20, 31, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		result := test.Phase(test.K)
+		if diff := result.Sub(test.Expected); diff < -time.Minute || diff > time.Minute {
+			t.Errorf("%d: want %s got %s\n", test.K, test.Expected, result)
+		}
+	}
+}
+
+func Test_Julian_JulianDay(t *testing.T) {
+	date := time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)
+	if jd := astro.JulianDay(date); jd != 2451545 {
+		t.Errorf("want 2451545 got %f\n", jd)
+	}
+
+	// Meeus example 7.a, 1957 October 4.81
+	date = time.Date(1957, 10, 4, 19, 26, 24, 0, time.UTC)
+	if jd := astro.JulianDay(date); jd != 2436116.31 {
+		t.Errorf("want 2436116.31 got %f\n", jd)
+	}
+
+	if result := astro.JulianDayToTime(2436116.31); !result.Equal(date) {
+		t.Errorf("want %s got %s\n", date, result)
+	}
+}
+
+func Test_Phase_Illumination(t *testing.T) {
+	// Meeus example 48.a, 1992 April 12 at 0h TD
+	date := time.Date(1992, 4, 11, 23, 59, 1, 0, time.UTC)
+	if result := astro.PhaseAngle(date); math.Abs(result-69.0756) > 0.01 {
+		t.Errorf("want phase angle 69.0756 got %f\n", result)
+	}
+
+	if result := astro.Illumination(date); math.Abs(result-0.6786) > 0.0005 {
+		t.Errorf("want illumination 0.6786 got %f\n", result)
+	}
+
+	// Nearly dark at new moon and fully lit at full moon
+	if result := astro.Illumination(astro.NewMoon(300)); result > 0.001 {
+		t.Errorf("want dark moon got %f\n", result)
+	}
+
+	if result := astro.Illumination(astro.FullMoon(300)); result < 0.999 {
+		t.Errorf("want full moon got %f\n", result)
+	}
+}
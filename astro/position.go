@@ -0,0 +1,207 @@
+package astro
+
+import (
+	"math"
+	"time"
+)
+
+// earthRadius is the equatorial radius of Earth in kilometers.
+const earthRadius = 6378.14
+
+// earthAxisRatio is the ratio between polar and equatorial radius of Earth.
+const earthAxisRatio = 0.99664719
+
+// moonRadius is the radius of the Moon in Earth's equatorial radius.
+const moonRadius = 0.272481
+
+// sunRadius is the semidiameter of the Sun at 1 AU in degrees.
+const sunRadius = 959.63 / 3600
+
+// Body is a celestial body which position can be calculated by this package.
+type Body int
+
+const (
+	// Sun is the star at the center of Solar System.
+	Sun Body = iota
+
+	// Moon is the natural satellite of Earth.
+	Moon
+)
+
+// String returns the English name of the body.
+func (b Body) String() string {
+	switch b {
+	case Sun:
+		return "Sun"
+	case Moon:
+		return "Moon"
+	default:
+		return "Unknown"
+	}
+}
+
+// Observer is a location on Earth's surface where the observation happened.
+type Observer struct {
+	// Latitude in degrees, positive to the north.
+	Latitude float64
+
+	// Longitude in degrees, positive to the east of Greenwich.
+	Longitude float64
+
+	// Elevation in meters above the sea level.
+	Elevation float64
+
+	// Refraction, Limb and Horizon is the convention used to decide when a body rises or sets, and
+	// to calculate its apparent altitude. The zero values are the common convention, i.e. the upper
+	// limb touching the standard horizon with 34 arcminutes of refraction.
+	Refraction Refraction
+	Limb       Limb
+	Horizon    Horizon
+}
+
+// Position is the apparent position of a body at an instant. The angles are in degrees while the
+// distances are in kilometers. Every altitude here is the geometric altitude of the center of the
+// body, i.e. without atmospheric refraction.
+type Position struct {
+	// Longitude and Latitude is the apparent geocentric ecliptic coordinates.
+	Longitude float64
+	Latitude  float64
+
+	// RightAscension, Declination and Distance is the apparent geocentric equatorial coordinates.
+	RightAscension float64
+	Declination    float64
+	Distance       float64
+
+	// GeocentricAzimuth and GeocentricAltitude is the horizontal coordinates of the body when
+	// seen from the center of Earth, i.e. without parallax.
+	GeocentricAzimuth  float64
+	GeocentricAltitude float64
+
+	// TopocentricRightAscension, TopocentricDeclination and TopocentricDistance is the apparent
+	// equatorial coordinates seen by the observer.
+	TopocentricRightAscension float64
+	TopocentricDeclination    float64
+	TopocentricDistance       float64
+
+	// HourAngle, Azimuth and Altitude is the topocentric horizontal coordinates seen by the
+	// observer. Azimuth is measured eastward from the north.
+	HourAngle float64
+	Azimuth   float64
+	Altitude  float64
+
+	// Semidiameter is the apparent radius of the body seen by the observer.
+	Semidiameter float64
+}
+
+// Position returns the apparent position of the body at the specified instant, seen by observer.
+func (b Body) Position(t time.Time, obs Observer) Position {
+	return b.position(JulianDay(t), obs)
+}
+
+func (b Body) position(jd float64, obs Observer) Position {
+	jde := utToTT(jd)
+
+	// Calculate geocentric ecliptic coordinates
+	var pos Position
+	switch b {
+	case Sun:
+		pos.Longitude, pos.Distance = sunEcliptic(jde)
+	case Moon:
+		pos.Longitude, pos.Latitude, pos.Distance = moonEcliptic(jde)
+	}
+
+	// Convert it to equatorial coordinates using the true obliquity
+	_, deltaEpsilon := nutation(jde)
+	epsilon := meanObliquity(jde) + deltaEpsilon
+	pos.RightAscension, pos.Declination = eclipticToEquatorial(pos.Longitude, pos.Latitude, epsilon)
+
+	// Calculate local sidereal time
+	localSiderealTime := normalizeDegrees(apparentSiderealTime(jd) + obs.Longitude)
+
+	// Geocentric horizontal coordinates
+	geoHourAngle := localSiderealTime - pos.RightAscension
+	pos.GeocentricAzimuth, pos.GeocentricAltitude = equatorialToHorizontal(
+		geoHourAngle, pos.Declination, obs.Latitude)
+
+	// Move the body position from center of Earth to the observer, using rectangular coordinates
+	rhoSin, rhoCos := observerGeocentric(obs)
+	x := pos.Distance*cos(pos.Declination)*cos(pos.RightAscension) - earthRadius*rhoCos*cos(localSiderealTime)
+	y := pos.Distance*cos(pos.Declination)*sin(pos.RightAscension) - earthRadius*rhoCos*sin(localSiderealTime)
+	z := pos.Distance*sin(pos.Declination) - earthRadius*rhoSin
+
+	pos.TopocentricDistance = math.Sqrt(x*x + y*y + z*z)
+	pos.TopocentricRightAscension = normalizeDegrees(atan2(y, x))
+	pos.TopocentricDeclination = asin(z / pos.TopocentricDistance)
+
+	// Topocentric horizontal coordinates
+	pos.HourAngle = normalizeDegrees(localSiderealTime - pos.TopocentricRightAscension)
+	pos.Azimuth, pos.Altitude = equatorialToHorizontal(
+		pos.HourAngle, pos.TopocentricDeclination, obs.Latitude)
+
+	// Apparent radius of the body
+	switch b {
+	case Sun:
+		pos.Semidiameter = sunRadius * auInKm / pos.TopocentricDistance
+	case Moon:
+		pos.Semidiameter = asin(moonRadius * earthRadius / pos.TopocentricDistance)
+	}
+
+	return pos
+}
+
+// MeanSiderealTime returns the mean sidereal time at Greenwich in degrees.
+func MeanSiderealTime(t time.Time) float64 {
+	return meanSiderealTime(JulianDay(t))
+}
+
+// ApparentSiderealTime returns the apparent sidereal time at Greenwich in degrees, i.e. the mean
+// sidereal time corrected for nutation.
+func ApparentSiderealTime(t time.Time) float64 {
+	return apparentSiderealTime(JulianDay(t))
+}
+
+// meanSiderealTime calculates mean sidereal time at Greenwich using equation 12.4 of Meeus.
+func meanSiderealTime(jd float64) float64 {
+	T := julianCentury(jd)
+	theta := 280.46061837 + 360.98564736629*(jd-j2000) + 0.000387933*T*T - T*T*T/38710000
+	return normalizeDegrees(theta)
+}
+
+func apparentSiderealTime(jd float64) float64 {
+	jde := utToTT(jd)
+	deltaPsi, deltaEpsilon := nutation(jde)
+	epsilon := meanObliquity(jde) + deltaEpsilon
+	return normalizeDegrees(meanSiderealTime(jd) + deltaPsi*cos(epsilon))
+}
+
+// eclipticToEquatorial converts ecliptic coordinates into right ascension and declination.
+func eclipticToEquatorial(longitude, latitude, epsilon float64) (ra, dec float64) {
+	ra = atan2(sin(longitude)*cos(epsilon)-tan(latitude)*sin(epsilon), cos(longitude))
+	dec = asin(sin(latitude)*cos(epsilon) + cos(latitude)*sin(epsilon)*sin(longitude))
+	return normalizeDegrees(ra), dec
+}
+
+// equatorialToHorizontal converts hour angle and declination into azimuth (measured eastward from
+// the north) and altitude.
+func equatorialToHorizontal(hourAngle, dec, latitude float64) (azimuth, altitude float64) {
+	azimuth = atan2(-cos(dec)*sin(hourAngle), sin(dec)*cos(latitude)-cos(dec)*cos(hourAngle)*sin(latitude))
+	altitude = asin(sin(latitude)*sin(dec) + cos(latitude)*cos(dec)*cos(hourAngle))
+	return normalizeDegrees(azimuth), altitude
+}
+
+// observerGeocentric returns ρ sin φ' and ρ cos φ' of the observer, i.e. its geocentric position
+// in Earth's equatorial radius, using the method from chapter 11 of Meeus.
+func observerGeocentric(obs Observer) (rhoSin, rhoCos float64) {
+	u := atan2(earthAxisRatio*sin(obs.Latitude), cos(obs.Latitude))
+	h := obs.Elevation / (earthRadius * 1000)
+	rhoSin = earthAxisRatio*sin(u) + h*sin(obs.Latitude)
+	rhoCos = cos(u) + h*cos(obs.Latitude)
+	return
+}
+
+// AngularSeparation returns the angle between two points in the sky, in degrees. Both of them must
+// use the same kind of spherical coordinates, e.g. right ascension and declination.
+func AngularSeparation(lon1, lat1, lon2, lat2 float64) float64 {
+	cosD := sin(lat1)*sin(lat2) + cos(lat1)*cos(lat2)*cos(lon1-lon2)
+	return acos(math.Max(-1, math.Min(1, cosD)))
+}
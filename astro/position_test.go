@@ -0,0 +1,129 @@
+package astro_test
+
+import (
+	"encoding/csv"
+	"math"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri/astro"
+)
+
+func Test_Position_Geocentric(t *testing.T) {
+	// Meeus example 25.a and 47.a, which times are in dynamical time. The Moon is also checked
+	// against the instant of its ascending node (51.a), apogee (50.a, distance from the parallax
+	// 3240.679") and greatest northern declination (52.a), where only the matching column is
+	// filled. Angles are checked within 0.001° unless the row has its own tolerance, since the
+	// extremes in Meeus are computed from shorter series. Distance is checked within 2e-5.
+	records := readFixture(t, "test/positions.csv")
+	for _, record := range records {
+		body := parseBody(t, record[0])
+		td, err := time.Parse(time.RFC3339, record[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		deltaT := astro.DeltaT(astro.JulianDay(td))
+		ut := td.Add(-time.Duration(deltaT * float64(time.Second)))
+		pos := body.Position(ut, astro.Observer{})
+
+		angleTolerance := 0.001
+		if record[7] != "" {
+			angleTolerance = parseFloat(t, record[7])
+		}
+
+		results := []float64{pos.Longitude, pos.Latitude, pos.Distance, pos.RightAscension, pos.Declination}
+		for i, result := range results {
+			if record[i+2] == "" {
+				continue
+			}
+
+			expected := parseFloat(t, record[i+2])
+			tolerance := angleTolerance
+			if i == 2 {
+				// Distance is in kilometers, so use relative tolerance
+				tolerance = expected * 2e-5
+			}
+
+			if math.Abs(result-expected) > tolerance {
+				t.Errorf("%s %s column %d: want %f got %f\n", record[0], record[1], i+2, expected, result)
+			}
+		}
+	}
+}
+
+func Test_Position_Topocentric(t *testing.T) {
+	// Near the horizon, the Moon is about 1° lower for observer than from the center of Earth
+	date := time.Date(2023, 4, 20, 11, 0, 0, 0, time.UTC)
+	obs := astro.Observer{Latitude: -6.2, Longitude: 106.816667, Elevation: 8}
+	pos := astro.Moon.Position(date, obs)
+
+	if parallax := pos.GeocentricAltitude - pos.Altitude; parallax < 0.8 || parallax > 1.0 {
+		t.Errorf("want lunar parallax around 0.9° got %f\n", parallax)
+	}
+
+	// When it's high in the sky, the observer is closer to the Moon than the center of Earth
+	location := time.FixedZone("WIB", 7*60*60)
+	transit := astro.Moon.Events(date.In(location), obs).Transit
+	pos = astro.Moon.Position(transit, obs)
+
+	if pos.TopocentricDistance >= pos.Distance {
+		t.Errorf("topocentric distance %f is not closer than %f\n", pos.TopocentricDistance, pos.Distance)
+	}
+
+	// For the Sun the parallax is less than 9 arcseconds
+	pos = astro.Sun.Position(date, obs)
+	if parallax := pos.GeocentricAltitude - pos.Altitude; parallax < 0 || parallax > 9.0/3600 {
+		t.Errorf("want solar parallax below 9\" got %f\n", parallax)
+	}
+}
+
+func Test_Position_SiderealTime(t *testing.T) {
+	// Meeus example 12.a, 1987 April 10 at 0h UT
+	date := time.Date(1987, 4, 10, 0, 0, 0, 0, time.UTC)
+	if result := astro.MeanSiderealTime(date); math.Abs(result-197.693195) > 0.00001 {
+		t.Errorf("want 197.693195 got %f\n", result)
+	}
+
+	if result := astro.ApparentSiderealTime(date); math.Abs(result-197.692230) > 0.0001 {
+		t.Errorf("want 197.692230 got %f\n", result)
+	}
+}
+
+func readFixture(t *testing.T, path string) [][]string {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Skip the header
+	return records[1:]
+}
+
+func parseBody(t *testing.T, name string) astro.Body {
+	for _, body := range []astro.Body{astro.Sun, astro.Moon} {
+		if body.String() == name {
+			return body
+		}
+	}
+
+	t.Fatalf("unknown body %s", name)
+	return 0
+}
+
+func parseFloat(t *testing.T, str string) float64 {
+	value, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return value
+}
@@ -0,0 +1,155 @@
+package astro
+
+import (
+	"math"
+	"time"
+)
+
+// siderealRate is the rotation of Earth relative to the stars, in degrees per solar day.
+const siderealRate = 360.985647
+
+// Events is the time when a body rises, crosses the meridian and sets within a day. Event that
+// doesn't happen in that day, e.g. in polar region or when moonrise happens after midnight, will
+// have zero time.
+type Events struct {
+	Rise    time.Time
+	Transit time.Time
+	Set     time.Time
+}
+
+// Events returns the rise, transit and set time of the body for the observer, within the day of
+// the specified date in its own location. Rise and set are the instant when the observer's limb
+// of the body touches its horizon, e.g. by default when the upper limb touches the standard
+// horizon with atmospheric refraction of 34 arcminutes.
+func (b Body) Events(date time.Time, obs Observer) Events {
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	end := start.AddDate(0, 0, 1)
+	jdStart, jdEnd := JulianDay(start), JulianDay(end)
+
+	// Check the transits around the day. Since the lunar day is longer than a solar day, there
+	// are at most one transit, one rise and one set within a day.
+	var transit, rise, set float64
+	for _, seed := range []float64{jdStart - 0.5, jdStart + 0.5, jdStart + 1.5} {
+		jdTransit := b.transit(seed, obs)
+		if transit == 0 && jdTransit >= jdStart && jdTransit < jdEnd {
+			transit = jdTransit
+		}
+
+		if jdRise, ok := b.horizonCrossing(jdTransit, obs, true, obs.horizonAltitude); ok && rise == 0 &&
+			jdRise >= jdStart && jdRise < jdEnd {
+			rise = jdRise
+		}
+
+		if jdSet, ok := b.horizonCrossing(jdTransit, obs, false, obs.horizonAltitude); ok && set == 0 &&
+			jdSet >= jdStart && jdSet < jdEnd {
+			set = jdSet
+		}
+	}
+
+	return Events{
+		Rise:    julianDayToLocation(rise, date.Location()),
+		Transit: julianDayToLocation(transit, date.Location()),
+		Set:     julianDayToLocation(set, date.Location()),
+	}
+}
+
+// Rise returns the time when the body rises within the day of the specified date in its own
+// location, or zero time if it doesn't rise that day. It's faster than Events when only one event
+// is needed.
+func (b Body) Rise(date time.Time, obs Observer) time.Time {
+	return b.crossingWithin(date, obs, true, obs.horizonAltitude)
+}
+
+// Set returns the time when the body sets within the day of the specified date in its own
+// location, or zero time if it doesn't set that day. It's faster than Events when only one event
+// is needed.
+func (b Body) Set(date time.Time, obs Observer) time.Time {
+	return b.crossingWithin(date, obs, false, obs.horizonAltitude)
+}
+
+// TimeAtAltitude returns the time within the day of the specified date in its own location, when
+// the center of the body reaches the altitude while rising or setting. The altitude is geometric,
+// so the refraction must be included in it, e.g. -18° for the start of astronomical dawn. It will
+// returns zero time if the body doesn't reach the altitude in that day.
+func (b Body) TimeAtAltitude(date time.Time, obs Observer, altitude float64, rising bool) time.Time {
+	return b.crossingWithin(date, obs, rising, func(Position) float64 { return altitude })
+}
+
+func (b Body) crossingWithin(date time.Time, obs Observer, rising bool, altitude func(Position) float64) time.Time {
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	end := start.AddDate(0, 0, 1)
+	jdStart, jdEnd := JulianDay(start), JulianDay(end)
+
+	// Start from the transit around the middle of the day, since that's the most likely one
+	for _, seed := range []float64{jdStart + 0.5, jdStart - 0.5, jdStart + 1.5} {
+		jdTransit := b.transit(seed, obs)
+		jd, ok := b.horizonCrossing(jdTransit, obs, rising, altitude)
+		if ok && jd >= jdStart && jd < jdEnd {
+			return julianDayToLocation(jd, date.Location())
+		}
+	}
+
+	return time.Time{}
+}
+
+// transit finds the upper transit of the body which is the closest to the specified Julian Day.
+func (b Body) transit(jd float64, obs Observer) float64 {
+	for i := 0; i < 10; i++ {
+		hourAngle := normalizeDegrees(b.position(jd, obs).HourAngle+180) - 180
+		jd -= hourAngle / siderealRate
+		if math.Abs(hourAngle) < 1e-5 {
+			break
+		}
+	}
+
+	return jd
+}
+
+// horizonCrossing finds the time when the body rises before, or sets after, the specified transit,
+// i.e. when its center reaches the altitude. It returns false if the body never reaches the
+// altitude around that transit.
+func (b Body) horizonCrossing(jdTransit float64, obs Observer, rising bool, altitude func(Position) float64) (float64, bool) {
+	// Estimate the hour angle when the body touches horizon
+	pos := b.position(jdTransit, obs)
+	cosH0 := (sin(altitude(pos)) - sin(obs.Latitude)*sin(pos.TopocentricDeclination)) /
+		(cos(obs.Latitude) * cos(pos.TopocentricDeclination))
+	if cosH0 < -1 || cosH0 > 1 {
+		return 0, false
+	}
+
+	jd := jdTransit + acos(cosH0)/siderealRate
+	if rising {
+		jd = jdTransit - acos(cosH0)/siderealRate
+	}
+
+	// Refine it using the actual altitude, since the body keeps moving
+	for i := 0; i < 10; i++ {
+		pos = b.position(jd, obs)
+		deltaAltitude := pos.Altitude - altitude(pos)
+		rate := siderealRate * cos(pos.TopocentricDeclination) * cos(obs.Latitude) * sin(pos.HourAngle)
+		if rate == 0 {
+			return 0, false
+		}
+
+		deltaJD := deltaAltitude / rate
+		jd += deltaJD
+		if math.Abs(deltaJD) < 1e-6 {
+			break
+		}
+	}
+
+	pos = b.position(jd, obs)
+	if math.Abs(pos.Altitude-altitude(pos)) > 0.01 {
+		return 0, false
+	}
+
+	return jd, true
+}
+
+func julianDayToLocation(jd float64, location *time.Location) time.Time {
+	if jd == 0 {
+		return time.Time{}
+	}
+
+	return JulianDayToTime(jd).In(location)
+}
@@ -0,0 +1,143 @@
+package astro_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri/astro"
+)
+
+func Test_RiseSet_Almanac(t *testing.T) {
+	// Sunrise, solar noon and sunset published by almanacs, rounded to minute
+	records := readFixture(t, "test/riseset.csv")
+	for _, record := range records {
+		body := parseBody(t, record[0])
+		location, err := time.LoadLocation(record[3])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		date, err := time.ParseInLocation("2006-01-02", record[2], location)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		obs := astro.Observer{
+			Latitude:  parseFloat(t, record[4]),
+			Longitude: parseFloat(t, record[5]),
+			Elevation: parseFloat(t, record[6]),
+		}
+
+		events := body.Events(date, obs)
+		results := []time.Time{events.Rise, events.Transit, events.Set}
+		for i, result := range results {
+			name := record[0] + " " + record[1] + " " + record[2]
+			if record[i+7] == "-" {
+				if !result.IsZero() {
+					t.Errorf("%s: want no event got %s\n", name, result)
+				}
+				continue
+			}
+
+			expected, err := time.ParseInLocation("2006-01-02 15:04", record[2]+" "+record[i+7], location)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := result.Sub(expected); diff < -time.Minute || diff > time.Minute {
+				t.Errorf("%s: want %s got %s\n", name, expected.Format("15:04"), result.Format("15:04:05"))
+			}
+		}
+	}
+}
+
+func Test_RiseSet_Moon(t *testing.T) {
+	location := time.FixedZone("WIB", 7*60*60)
+	obs := astro.Observer{Latitude: -6.2, Longitude: 106.816667, Elevation: 8}
+	date := time.Date(2023, 4, 1, 0, 0, 0, 0, location)
+
+	for i := 0; i < 30; i++ {
+		day := date.AddDate(0, 0, i)
+		events := astro.Moon.Events(day, obs)
+
+		for _, event := range []time.Time{events.Rise, events.Transit, events.Set} {
+			if !event.IsZero() && (event.Before(day) || !event.Before(day.AddDate(0, 0, 1))) {
+				t.Errorf("%s: event %s is outside the day\n", day.Format("2006-01-02"), event)
+			}
+		}
+
+		// When it rises and sets, the upper limb should be at the horizon with refraction
+		for _, event := range []time.Time{events.Rise, events.Set} {
+			if event.IsZero() {
+				continue
+			}
+
+			pos := astro.Moon.Position(event, obs)
+			if limb := pos.Altitude + pos.Semidiameter + 34.0/60; math.Abs(limb) > 0.01 {
+				t.Errorf("%s: upper limb at %f when moon rises or sets\n", day.Format("2006-01-02"), limb)
+			}
+		}
+
+		// At transit, the moon must be at the meridian
+		if !events.Transit.IsZero() {
+			hourAngle := astro.Moon.Position(events.Transit, obs).HourAngle
+			if hourAngle > 180 {
+				hourAngle -= 360
+			}
+
+			if math.Abs(hourAngle) > 0.01 {
+				t.Errorf("%s: hour angle %f at transit\n", day.Format("2006-01-02"), hourAngle)
+			}
+		}
+	}
+}
+
+func Test_RiseSet_TimeAtAltitude(t *testing.T) {
+	location := time.FixedZone("WIB", 7*60*60)
+	obs := astro.Observer{Latitude: -6.2, Longitude: 106.816667, Elevation: 8}
+	date := time.Date(2023, 4, 20, 0, 0, 0, 0, location)
+	events := astro.Sun.Events(date, obs)
+
+	// With the same altitude, it should be the same as sunset
+	sunset := astro.Sun.TimeAtAltitude(date, obs, -50.0/60, false)
+	if diff := sunset.Sub(events.Set); diff < -10*time.Second || diff > 10*time.Second {
+		t.Errorf("want %s got %s\n", events.Set, sunset)
+	}
+
+	// Astronomical dawn in the tropics is a bit more than an hour before sunrise
+	dawn := astro.Sun.TimeAtAltitude(date, obs, -18, true)
+	if diff := events.Rise.Sub(dawn); diff < time.Hour || diff > 80*time.Minute {
+		t.Errorf("dawn at %s is too far from sunrise %s\n", dawn, events.Rise)
+	}
+
+	if pos := astro.Sun.Position(dawn, obs); math.Abs(pos.Altitude+18) > 0.01 {
+		t.Errorf("want altitude -18 got %f\n", pos.Altitude)
+	}
+
+	// In polar summer, the Sun never goes that low
+	tromso := astro.Observer{Latitude: 69.6492, Longitude: 18.9553}
+	if dawn := astro.Sun.TimeAtAltitude(time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC), tromso, -18, true); !dawn.IsZero() {
+		t.Errorf("want no dawn got %s\n", dawn)
+	}
+}
+
+func Test_RiseSet_Single(t *testing.T) {
+	location := time.FixedZone("WIB", 7*60*60)
+	obs := astro.Observer{Latitude: -6.2, Longitude: 106.816667, Elevation: 8}
+	date := time.Date(2023, 4, 1, 0, 0, 0, 0, location)
+
+	for i := 0; i < 30; i++ {
+		day := date.AddDate(0, 0, i)
+		for _, body := range []astro.Body{astro.Sun, astro.Moon} {
+			events := body.Events(day, obs)
+			if rise := body.Rise(day, obs); !rise.Equal(events.Rise) {
+				t.Errorf("%s %s: want rise %s got %s\n", body, day.Format("2006-01-02"), events.Rise, rise)
+			}
+
+			if set := body.Set(day, obs); !set.Equal(events.Set) {
+				t.Errorf("%s %s: want set %s got %s\n", body, day.Format("2006-01-02"), events.Set, set)
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package astro
+
+// auInKm is astronomical unit in kilometers.
+const auInKm = 149597870.7
+
+// sunEcliptic calculates the apparent geocentric ecliptic longitude of the Sun in degrees and its
+// distance in kilometers, using the algorithm from chapter 25 of Meeus which accurate to 0.01°.
+func sunEcliptic(jde float64) (longitude, distance float64) {
+	T := julianCentury(jde)
+
+	// Geometric mean longitude, mean anomaly and eccentricity of Earth's orbit
+	L0 := 280.46646 + 36000.76983*T + 0.0003032*T*T
+	M := 357.52911 + 35999.05029*T - 0.0001537*T*T
+	e := 0.016708634 - 0.000042037*T - 0.0000001267*T*T
+
+	// Equation of center, to get the true longitude and true anomaly
+	C := (1.914602-0.004817*T-0.000014*T*T)*sin(M) +
+		(0.019993-0.000101*T)*sin(2*M) +
+		0.000289*sin(3*M)
+	trueLongitude := L0 + C
+	trueAnomaly := M + C
+
+	// Radius vector in AU
+	R := 1.000001018 * (1 - e*e) / (1 + e*cos(trueAnomaly))
+
+	// Apparent longitude, corrected for nutation and aberration
+	deltaPsi, _ := nutation(jde)
+	longitude = trueLongitude + deltaPsi - 20.4898/3600/R
+
+	return normalizeDegrees(longitude), R * auInKm
+}
@@ -0,0 +1,41 @@
+package astro
+
+import (
+	"math"
+	"time"
+)
+
+// Since Go time has no notion of time scale, the functions in this file simply shift the instant,
+// so the wall clock of the result shows the time in the target scale.
+
+// UTToTT converts Universal Time into Terrestrial Time.
+func UTToTT(t time.Time) time.Time {
+	return t.Add(secondsToDuration(DeltaT(JulianDay(t))))
+}
+
+// TTToUT converts Terrestrial Time into Universal Time.
+func TTToUT(t time.Time) time.Time {
+	return t.Add(-secondsToDuration(DeltaT(JulianDay(t))))
+}
+
+// TTToTDB converts Terrestrial Time into Barycentric Dynamical Time. Both of them only differ by
+// less than two milliseconds, because of the eccentricity of Earth's orbit.
+func TTToTDB(t time.Time) time.Time {
+	return t.Add(secondsToDuration(tdbMinusTT(JulianDay(t))))
+}
+
+// TDBToTT converts Barycentric Dynamical Time into Terrestrial Time.
+func TDBToTT(t time.Time) time.Time {
+	return t.Add(-secondsToDuration(tdbMinusTT(JulianDay(t))))
+}
+
+// tdbMinusTT returns the difference between TDB and TT in seconds, using the approximation from
+// the Explanatory Supplement to the Astronomical Almanac.
+func tdbMinusTT(jde float64) float64 {
+	g := 357.53 + 0.9856003*(jde-j2000)
+	return 0.001657*sin(g) + 0.000014*sin(2*g)
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Round(seconds * float64(time.Second)))
+}
@@ -0,0 +1,66 @@
+package astro_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri/astro"
+)
+
+func Test_TimeScale_DeltaT(t *testing.T) {
+	tests := []struct {
+		Date      time.Time
+		Expected  float64
+		Tolerance float64
+	}{
+		// Observed values from IERS
+		{time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC), 56.86, 0.01},
+		{time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), 63.83, 0.01},
+		{time.Date(2016, 7, 1, 0, 0, 0, 0, time.UTC), 68.35, 0.1},
+		{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 69.18, 0.01},
+
+		// Meeus example 10.a, and historical values from NASA's Five Millennium Canon
+		{time.Date(1977, 2, 18, 0, 0, 0, 0, time.UTC), 48, 1},
+		{time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), -2.79, 0.5},
+		{time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC), 1574, 20},
+	}
+
+	for _, test := range tests {
+		result := astro.DeltaT(astro.JulianDay(test.Date))
+		if math.Abs(result-test.Expected) > test.Tolerance {
+			t.Errorf("%s: want %.2f got %.2f\n", test.Date.Format("2006-01-02"), test.Expected, result)
+		}
+	}
+
+	// Delta T stays continuous around the last observed year. The polynomials use the month as
+	// their variable, so they change a bit at each new month.
+	for year := 2020; year < 2040; year++ {
+		jd := astro.JulianDay(time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC))
+		if diff := astro.DeltaT(jd+1) - astro.DeltaT(jd-1); math.Abs(diff) > 0.1 {
+			t.Errorf("%d: Delta T jumps by %f seconds\n", year, diff)
+		}
+	}
+}
+
+func Test_TimeScale_Conversion(t *testing.T) {
+	ut := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tt := astro.UTToTT(ut)
+	if diff := tt.Sub(ut).Seconds(); math.Abs(diff-69.18) > 0.01 {
+		t.Errorf("want TT - UT 69.18 got %f\n", diff)
+	}
+
+	if result := astro.TTToUT(tt); result.Sub(ut) > time.Millisecond || result.Sub(ut) < -time.Millisecond {
+		t.Errorf("want %s got %s\n", ut, result)
+	}
+
+	// TDB and TT differ by less than 2 ms
+	tdb := astro.TTToTDB(tt)
+	if diff := tdb.Sub(tt); diff > 2*time.Millisecond || diff < -2*time.Millisecond {
+		t.Errorf("TDB - TT is %s\n", diff)
+	}
+
+	if result := astro.TDBToTT(tdb); result.Sub(tt) > time.Microsecond || result.Sub(tt) < -time.Microsecond {
+		t.Errorf("want %s got %s\n", tt, result)
+	}
+}
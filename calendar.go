@@ -0,0 +1,134 @@
+package hijri
+
+import (
+	"time"
+
+	"github.com/hablullah/go-juliandays"
+)
+
+// Calendar is a Hijri calendar system which able to tell the Gregorian date when each of its
+// month started. Both the arithmetic calendar (through its LeapYearsPattern) and Umm al-Qura
+// calendar implement this interface, so the same functions can be used to inspect both of them.
+type Calendar interface {
+	// MonthStart returns the Gregorian date of the first day of the specified Hijri month.
+	MonthStart(year, month int64) (time.Time, error)
+}
+
+// snapshotter is a calendar which month starts can change over time, e.g. AdjustedCalendar. Its
+// snapshot is used when a conversion needs several month starts, so all of them are consistent.
+type snapshotter interface {
+	Snapshot() Calendar
+}
+
+// snapshotCalendar returns the current snapshot of the calendar if it can change over time, or the
+// calendar itself otherwise.
+func snapshotCalendar(cal Calendar) Calendar {
+	if s, ok := cal.(snapshotter); ok {
+		return s.Snapshot()
+	}
+
+	return cal
+}
+
+// Month is a single month within a Hijri year.
+type Month struct {
+	Year  int64
+	Month int64
+	Start time.Time
+	Days  int64
+}
+
+// Conjunction returns the instant of new moon before the month started. Unlike the other fields
+// it's computed from the lunar ephemeris, so it's only calculated when requested.
+func (m Month) Conjunction() time.Time {
+	return Conjunction(m.Year, m.Month)
+}
+
+// Months returns the list of the twelve months in the specified Hijri year, complete with
+// their Gregorian start date and their length in days.
+func Months(cal Calendar, year int64) ([]Month, error) {
+	// Fetch the start of each month, plus the start of next year to find the last month's length
+	cal = snapshotCalendar(cal)
+	starts := make([]int64, 13)
+	for i := range starts {
+		start, err := cal.MonthStart(year+int64(i/12), int64(i%12)+1)
+		if err != nil {
+			return nil, err
+		}
+
+		starts[i], err = timeToJDN(start)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Create the list of months
+	months := make([]Month, 12)
+	for i := range months {
+		month := int64(i + 1)
+		months[i] = Month{
+			Year:  year,
+			Month: month,
+			Start: jdnToTime(starts[i]),
+			Days:  starts[i+1] - starts[i],
+		}
+	}
+
+	return months, nil
+}
+
+// YearStart returns the Gregorian date of 1 Muharram in the specified Hijri year.
+func YearStart(cal Calendar, year int64) (time.Time, error) {
+	return cal.MonthStart(year, 1)
+}
+
+// MonthLengths returns the number of days in each month of the specified Hijri year.
+func MonthLengths(cal Calendar, year int64) ([]int64, error) {
+	months, err := Months(cal, year)
+	if err != nil {
+		return nil, err
+	}
+
+	lengths := make([]int64, len(months))
+	for i, month := range months {
+		lengths[i] = month.Days
+	}
+
+	return lengths, nil
+}
+
+// timeToJDN converts the date of Golang time into Chronological Julian Day Number (CJDN).
+func timeToJDN(date time.Time) (int64, error) {
+	// Set the time to noon, so Julian Days will be a whole number
+	date = date.UTC()
+	date = time.Date(date.Year(), date.Month(), date.Day(), 12, 0, 0, 0, time.UTC)
+
+	jd, err := juliandays.FromTime(date)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(jd), nil
+}
+
+// jdnToTime converts Chronological Julian Day Number (CJDN) into Golang time at midnight UTC.
+func jdnToTime(cjdn int64) time.Time {
+	return juliandays.ToTime(float64(cjdn) - 0.5)
+}
+
+// jdnWeekday returns the day of the week of Chronological Julian Day Number.
+func jdnWeekday(cjdn int64) time.Weekday {
+	return time.Weekday((cjdn%7 + 8) % 7)
+}
+
+// yearWeek returns the week number of a day, where the first week is the one that contains the
+// first day of the year and each week begins on firstDay.
+func yearWeek(yearDay int64, weekday, firstDay time.Weekday) int64 {
+	// Find the weekday of the first day in the year
+	newYearWeekday := (int64(weekday) - (yearDay-1)%7 + 7) % 7
+
+	// Find how many days of the first week happened before the new year
+	offset := (newYearWeekday - int64(firstDay) + 7) % 7
+
+	return (yearDay-1+offset)/7 + 1
+}
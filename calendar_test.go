@@ -0,0 +1,103 @@
+package hijri_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_Calendar_Months(t *testing.T) {
+	calendars := map[string]struct {
+		Calendar hijri.Calendar
+		TestData []TestData
+	}{
+		"Hijri":       {hijri.Default, hijriTestData},
+		"Umm al-Qura": {hijri.UmmAlQura, ummAlQuraTestData},
+	}
+
+	for name, c := range calendars {
+		// Collect the month starts from test data
+		monthStarts := map[string]string{}
+		for _, data := range c.TestData {
+			if strings.HasSuffix(data.Hijri, "-01") {
+				monthStarts[data.Hijri[:7]] = data.Gregorian
+			}
+		}
+
+		// Compare it with the months from calendar
+		for year := int64(1411); year <= 1472; year++ {
+			months, err := hijri.Months(c.Calendar, year)
+			if err != nil {
+				t.Fatalf("%s %d: %v\n", name, year, err)
+			}
+
+			var nDays int64
+			for _, month := range months {
+				nDays += month.Days
+				if month.Days != 29 && month.Days != 30 {
+					t.Errorf("%s %04d-%02d: got %d days\n", name, year, month.Month, month.Days)
+				}
+
+				key := fmt.Sprintf("%04d-%02d", month.Year, month.Month)
+				start := month.Start.Format("2006-01-02")
+				if want := monthStarts[key]; start != want {
+					t.Errorf("%s %s: want %s got %s\n", name, key, want, start)
+				}
+			}
+
+			if nDays != 354 && nDays != 355 {
+				t.Errorf("%s %04d: got %d days\n", name, year, nDays)
+			}
+		}
+	}
+}
+
+func Test_Calendar_YearStart(t *testing.T) {
+	tests := []struct {
+		Calendar hijri.Calendar
+		Year     int64
+		Expected string
+	}{
+		{hijri.UmmAlQura, 1356, "1937-03-14"},
+		{hijri.UmmAlQura, 1447, "2025-06-26"},
+		{hijri.UmmAlQura, 1501, "2077-11-17"},
+		{hijri.Default, 1, "0622-07-16"},
+	}
+
+	for _, test := range tests {
+		start, err := hijri.YearStart(test.Calendar, test.Year)
+		if err != nil {
+			t.Errorf("%d: %v\n", test.Year, err)
+			continue
+		}
+
+		if result := start.Format("2006-01-02"); result != test.Expected {
+			t.Errorf("%d: want %s got %s\n", test.Year, test.Expected, result)
+		}
+	}
+}
+
+func Test_Calendar_MonthLengths(t *testing.T) {
+	lengths, err := hijri.MonthLengths(hijri.Default, 1412)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "[30 29 30 29 30 29 30 29 30 29 30 30]"
+	if result := fmt.Sprint(lengths); result != expected {
+		t.Errorf("want %s got %s\n", expected, result)
+	}
+
+	// Outside of Umm al-Qura scope must be error
+	for _, year := range []int64{1355, 1501} {
+		if _, err := hijri.MonthLengths(hijri.UmmAlQura, year); err == nil {
+			t.Errorf("Umm al-Qura %d: want error got nil\n", year)
+		}
+	}
+
+	if _, err := hijri.Default.MonthStart(0, 1); err == nil {
+		t.Error("Hijri year 0: want error got nil")
+	}
+}
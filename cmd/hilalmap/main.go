@@ -0,0 +1,127 @@
+// Command hilalmap draws the world map of crescent visibility on the evening before a Hijri month
+// starts, as PNG image and GeoJSON file.
+//
+// Usage:
+//
+//	hilalmap -year 1447 -month 9 -png ramadan.png -geojson ramadan.geojson
+//
+// By default the month start is taken from Umm al-Qura calendar and the visibility is predicted
+// using Yallop's criterion. Use -date to draw the map for a specific evening instead.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func main() {
+	year := flag.Int64("year", 0, "Hijri year")
+	month := flag.Int64("month", 0, "Hijri month, 1 to 12")
+	date := flag.String("date", "", "Gregorian date of the evening in format YYYY-MM-DD, instead of year and month")
+	calendar := flag.String("calendar", "ummalqura", "calendar for the month start: ummalqura, hijri, fcna or khgt")
+	criterion := flag.String("criterion", "yallop", "visibility criterion: yallop or odeh")
+	step := flag.Float64("step", hijri.DefaultMapStep, "size of each cell in degrees")
+	maxLatitude := flag.Float64("lat", hijri.DefaultMapMaxLatitude, "maximum latitude of the map in degrees")
+	cellSize := flag.Int("cell", 4, "size of each cell in the PNG image, in pixels")
+	pngPath := flag.String("png", "", "output path for PNG image")
+	geoJSONPath := flag.String("geojson", "", "output path for GeoJSON file")
+	flag.Parse()
+
+	if err := run(*year, *month, *date, *calendar, *criterion, *step, *maxLatitude,
+		*cellSize, *pngPath, *geoJSONPath); err != nil {
+		fmt.Fprintln(os.Stderr, "hilalmap:", err)
+		os.Exit(1)
+	}
+}
+
+func run(year, month int64, date, calendar, criterion string, step, maxLatitude float64,
+	cellSize int, pngPath, geoJSONPath string) error {
+	if pngPath == "" && geoJSONPath == "" {
+		return fmt.Errorf("at least one of -png or -geojson is required")
+	}
+
+	// Find the visibility test
+	var test func(hijri.CrescentObservation) hijri.VisibilityTest
+	switch criterion {
+	case "yallop":
+		test = hijri.YallopTest
+	case "odeh":
+		test = hijri.OdehTest
+	default:
+		return fmt.Errorf("unknown criterion %q", criterion)
+	}
+
+	// Find the evening
+	var evening time.Time
+	if date != "" {
+		var err error
+		if evening, err = time.Parse("2006-01-02", date); err != nil {
+			return err
+		}
+	} else {
+		cal, err := parseCalendar(calendar)
+		if err != nil {
+			return err
+		}
+
+		start, err := cal.MonthStart(year, month)
+		if err != nil {
+			return err
+		}
+
+		evening = start.AddDate(0, 0, -1)
+	}
+
+	// Create and save the map
+	vm, err := hijri.NewVisibilityMap(evening, test, maxLatitude, step)
+	if err != nil {
+		return err
+	}
+
+	if pngPath != "" {
+		if err = writeFile(pngPath, func(f *os.File) error { return vm.WritePNG(f, cellSize) }); err != nil {
+			return err
+		}
+	}
+
+	if geoJSONPath != "" {
+		if err = writeFile(geoJSONPath, func(f *os.File) error { return vm.WriteGeoJSON(f) }); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseCalendar(name string) (hijri.Calendar, error) {
+	switch name {
+	case "ummalqura":
+		return hijri.UmmAlQura, nil
+	case "hijri":
+		return hijri.Default, nil
+	case "fcna":
+		return hijri.FCNA, nil
+	case "khgt":
+		return hijri.KHGT, nil
+	default:
+		return nil, fmt.Errorf("unknown calendar %q", name)
+	}
+}
+
+func writeFile(path string, write func(*os.File) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err = write(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
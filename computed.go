@@ -0,0 +1,108 @@
+package hijri
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hablullah/go-hijri/astro"
+)
+
+// CrescentCalendar is a Hijri calendar computed from the crescent at a reference location. The
+// crescent is checked on the evening of the local day of conjunction. If it passes the criterion,
+// the month starts on the next day, otherwise it starts two days after the day of conjunction.
+//
+// The calendar caches each month start, so it can be used as often as the tabular calendars.
+type CrescentCalendar struct {
+	observer  astro.Observer
+	criterion Criterion
+
+	mutex  sync.Mutex
+	starts map[int64]int64
+}
+
+// NewCrescentCalendar creates a calendar using the criterion at the observer location. The
+// observer's longitude also decides the local date of each evening.
+func NewCrescentCalendar(criterion Criterion, obs astro.Observer) *CrescentCalendar {
+	return &CrescentCalendar{
+		observer:  obs,
+		criterion: criterion,
+		starts:    map[int64]int64{},
+	}
+}
+
+// NewYallopCalendar creates a calendar where the crescent must be visible by naked eye according
+// to Yallop's criterion at the observer location.
+func NewYallopCalendar(obs astro.Observer) *CrescentCalendar {
+	return NewCrescentCalendar(YallopCriterion, obs)
+}
+
+// NewOdehCalendar creates a calendar where the crescent must be visible by naked eye according
+// to Odeh's criterion at the observer location.
+func NewOdehCalendar(obs astro.Observer) *CrescentCalendar {
+	return NewCrescentCalendar(OdehCriterion, obs)
+}
+
+// NewWujudulHilalCalendar creates a calendar using Wujudul Hilal rule at the observer location,
+// which is used by Muhammadiyah with Yogyakarta as its reference.
+func NewWujudulHilalCalendar(obs astro.Observer) *CrescentCalendar {
+	return NewCrescentCalendar(WujudulHilalCriterion, obs)
+}
+
+// NewMABIMSCalendar creates a calendar using neo-MABIMS rule at the observer location, which is
+// used by the governments of Indonesia, Malaysia, Brunei and Singapore.
+func NewMABIMSCalendar(obs astro.Observer) *CrescentCalendar {
+	return NewCrescentCalendar(MABIMSCriterion, obs)
+}
+
+// Observer returns the reference location of the calendar.
+func (cc *CrescentCalendar) Observer() astro.Observer {
+	return cc.observer
+}
+
+// Criterion returns the criterion used by the calendar.
+func (cc *CrescentCalendar) Criterion() Criterion {
+	return cc.criterion
+}
+
+// CreateDate converts Gregorian date to Hijri date using this calendar.
+func (cc *CrescentCalendar) CreateDate(date time.Time) (Date, error) {
+	return CreateDate(date, cc)
+}
+
+// MonthStart returns the Gregorian date of the first day of the specified Hijri month.
+func (cc *CrescentCalendar) MonthStart(year, month int64) (time.Time, error) {
+	if year < 1 {
+		return time.Time{}, errors.New("year must be greater than zero")
+	}
+
+	if month < 1 || month > 12 {
+		return time.Time{}, errors.New("month must be between 1 and 12")
+	}
+
+	iln := lunationNumber(year, month)
+	cc.mutex.Lock()
+	start, cached := cc.starts[iln]
+	cc.mutex.Unlock()
+
+	if cached {
+		return jdnToTime(start), nil
+	}
+
+	decision, err := cc.Explain(year, month)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	monthStart := decision.Start
+	start, err = timeToJDN(monthStart)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	cc.mutex.Lock()
+	cc.starts[iln] = start
+	cc.mutex.Unlock()
+
+	return monthStart, nil
+}
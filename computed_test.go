@@ -0,0 +1,64 @@
+package hijri_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_Computed_CrescentCalendar(t *testing.T) {
+	tests := []struct {
+		Calendar *hijri.CrescentCalendar
+		Year     int64
+		Month    int64
+		Expected string
+	}{
+		{hijri.NewYallopCalendar(hijri.Mecca), 1444, 10, "2023-04-22"},
+		{hijri.NewYallopCalendar(hijri.Mecca), 1445, 9, "2024-03-12"},
+		{hijri.NewYallopCalendar(hijri.Mecca), 1445, 10, "2024-04-10"},
+		{hijri.NewOdehCalendar(hijri.Mecca), 1444, 10, "2023-04-22"},
+		{hijri.NewOdehCalendar(hijri.Jakarta), 1445, 9, "2024-03-12"},
+		{hijri.NewOdehCalendar(hijri.Jakarta), 1445, 10, "2024-04-11"},
+	}
+
+	for _, test := range tests {
+		start, err := test.Calendar.MonthStart(test.Year, test.Month)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if result := start.Format("2006-01-02"); result != test.Expected {
+			t.Errorf("%04d-%02d: want %s got %s\n", test.Year, test.Month, test.Expected, result)
+		}
+	}
+}
+
+func Test_Computed_CreateDate(t *testing.T) {
+	cal := hijri.NewYallopCalendar(hijri.Mecca)
+	months, err := hijri.Months(cal, 1445)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, month := range months {
+		if month.Days != 29 && month.Days != 30 {
+			t.Errorf("1445-%02d: has %d days\n", month.Month, month.Days)
+		}
+
+		// The month never starts before the crescent could be seen
+		if month.Start.Sub(month.Conjunction()) < 12*time.Hour {
+			t.Errorf("1445-%02d: start %s is too close to conjunction %s\n", month.Month,
+				month.Start.Format("2006-01-02"), month.Conjunction())
+		}
+	}
+
+	date, err := hijri.CreateDate(time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC), cal)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if date.Year != 1445 || date.Month != 10 || date.Day != 1 {
+		t.Errorf("want 1445-10-01 got %04d-%02d-%02d\n", date.Year, date.Month, date.Day)
+	}
+}
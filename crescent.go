@@ -0,0 +1,142 @@
+package hijri
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/hablullah/go-hijri/astro"
+)
+
+// CrescentObservation is the circumstance of the young crescent seen by an observer in the
+// evening, which is used by crescent visibility criteria. The angles are in degrees and, unless
+// mentioned otherwise, they are topocentric and without atmospheric refraction.
+type CrescentObservation struct {
+	Observer    astro.Observer
+	Conjunction time.Time
+	Sunset      time.Time
+	Moonset     time.Time
+
+	// Lag is the duration between sunset and moonset, which is negative when the Moon sets first.
+	Lag time.Duration
+
+	// Age is the duration between conjunction and sunset, which is negative when the conjunction
+	// hasn't happened yet at sunset.
+	Age time.Duration
+
+	// MoonAltitude and Elongation is the altitude of the Moon center and its angular distance from
+	// the Sun at sunset, followed by the azimuth of both bodies and the illuminated fraction of the
+	// Moon's disk at the same time. MoonApparentAltitude is the altitude of the Moon at sunset using
+	// the observer's conventions of limb, refraction and horizon.
+	MoonAltitude         float64
+	MoonApparentAltitude float64
+	Elongation           float64
+	GeocentricElongation float64
+	SunAzimuth           float64
+	MoonAzimuth          float64
+	Illumination         float64
+
+	// BestTime is the best time to observe the crescent according to Yallop, i.e. four ninths
+	// of the lag after sunset. The following values are measured at that time.
+	BestTime time.Time
+
+	// ARCL is the angular distance between the Sun and the Moon.
+	ARCL float64
+
+	// ARCV is the difference of geocentric altitude between the Moon and the Sun, while
+	// TopocentricARCV is the same difference seen by the observer.
+	ARCV            float64
+	TopocentricARCV float64
+
+	// DAZ is the difference of azimuth between the Sun and the Moon, in range [-180, 180).
+	DAZ float64
+
+	// Width is the width of the crescent in arcminutes.
+	Width float64
+}
+
+// ObserveCrescent calculates the circumstance of the crescent on the evening of the specified
+// date at the observer location. Only the year, month and day of the date are used, and they are
+// treated as the local date at the observer's longitude. Sunset and moonset follow the observer's
+// conventions of limb, refraction and horizon. It will returns error if the Sun or the Moon
+// doesn't set that evening, which might happen in the polar region.
+func ObserveCrescent(date time.Time, obs astro.Observer) (CrescentObservation, error) {
+	// Find the sunset and the moonset which is the closest to it
+	location := observerLocation(obs)
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location)
+
+	sunset := astro.Sun.Set(day, obs)
+	if sunset.IsZero() {
+		return CrescentObservation{}, errors.New("sun doesn't set at the observer location")
+	}
+
+	// Usually the moonset is on the same day, except in high latitude or when the Moon is old
+	moonset := astro.Moon.Set(day, obs)
+	if moonset.IsZero() || sunset.Sub(moonset) > 12*time.Hour {
+		moonset = astro.Moon.Set(day.AddDate(0, 0, 1), obs)
+	}
+
+	if moonset.IsZero() {
+		return CrescentObservation{}, errors.New("moon doesn't set at the observer location")
+	}
+
+	// Use the conjunction that closest to this evening
+	k := astro.Lunation(sunset)
+	conjunction := astro.NewMoon(k)
+	if next := astro.NewMoon(k + 1); next.Sub(sunset) < sunset.Sub(conjunction) {
+		conjunction = next
+	}
+
+	crescent := CrescentObservation{
+		Observer:    obs,
+		Conjunction: conjunction,
+		Sunset:      sunset,
+		Moonset:     moonset,
+		Lag:         moonset.Sub(sunset),
+		Age:         sunset.Sub(conjunction),
+	}
+
+	// Position at sunset
+	sun := astro.Sun.Position(sunset, obs)
+	moon := astro.Moon.Position(sunset, obs)
+	crescent.MoonAltitude = moon.Altitude
+	crescent.MoonApparentAltitude = obs.ApparentAltitude(moon)
+	crescent.Elongation = astro.AngularSeparation(
+		sun.TopocentricRightAscension, sun.TopocentricDeclination,
+		moon.TopocentricRightAscension, moon.TopocentricDeclination)
+	crescent.GeocentricElongation = astro.AngularSeparation(
+		sun.Longitude, sun.Latitude, moon.Longitude, moon.Latitude)
+	crescent.SunAzimuth = sun.Azimuth
+	crescent.MoonAzimuth = moon.Azimuth
+	crescent.Illumination = astro.Illumination(sunset)
+
+	// Position at the best time
+	crescent.BestTime = sunset
+	if crescent.Lag > 0 {
+		crescent.BestTime = sunset.Add(crescent.Lag * 4 / 9)
+	}
+
+	sun = astro.Sun.Position(crescent.BestTime, obs)
+	moon = astro.Moon.Position(crescent.BestTime, obs)
+	crescent.ARCL = astro.AngularSeparation(
+		sun.TopocentricRightAscension, sun.TopocentricDeclination,
+		moon.TopocentricRightAscension, moon.TopocentricDeclination)
+	crescent.ARCV = moon.GeocentricAltitude - sun.GeocentricAltitude
+	crescent.TopocentricARCV = moon.Altitude - sun.Altitude
+	crescent.DAZ = azimuthDifference(sun.Azimuth, moon.Azimuth)
+	crescent.Width = moon.Semidiameter * 60 * (1 - math.Cos(crescent.ARCL*math.Pi/180))
+
+	return crescent, nil
+}
+
+// observerLocation returns the time zone of local mean time at the observer's longitude.
+func observerLocation(obs astro.Observer) *time.Location {
+	offset := int(math.Round(obs.Longitude / 15 * 60 * 60))
+	return time.FixedZone("LMT", offset)
+}
+
+// azimuthDifference returns the azimuth a minus b in range [-180, 180), so the bodies on both
+// sides of north are not 360° apart.
+func azimuthDifference(a, b float64) float64 {
+	return normalizeLongitude(a - b)
+}
@@ -0,0 +1,113 @@
+package hijri_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+	"github.com/hablullah/go-hijri/astro"
+)
+
+func Test_Crescent_Observation(t *testing.T) {
+	// Conjunction of Shawwal 1444 H happened on 20 April 2023 at 04:13 UTC, during the hybrid
+	// solar eclipse. That evening the moon was below 2° in Jakarta, then 12° on the next day.
+	tests := []struct {
+		Date        time.Time
+		Observer    astro.Observer
+		MinAltitude float64
+		MaxAltitude float64
+		MinLag      time.Duration
+		MaxLag      time.Duration
+	}{
+		{time.Date(2023, 4, 20, 0, 0, 0, 0, time.UTC), hijri.Jakarta, 1, 2, 5 * time.Minute, 15 * time.Minute},
+		{time.Date(2023, 4, 21, 0, 0, 0, 0, time.UTC), hijri.Jakarta, 11, 13, 50 * time.Minute, time.Hour},
+		{time.Date(2023, 4, 20, 0, 0, 0, 0, time.UTC), hijri.Mecca, 3.5, 5, 20 * time.Minute, 30 * time.Minute},
+	}
+
+	for _, test := range tests {
+		crescent, err := hijri.ObserveCrescent(test.Date, test.Observer)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		date := test.Date.Format("2006-01-02")
+		if crescent.MoonAltitude < test.MinAltitude || crescent.MoonAltitude > test.MaxAltitude {
+			t.Errorf("%s: want altitude between %.1f and %.1f got %f\n", date,
+				test.MinAltitude, test.MaxAltitude, crescent.MoonAltitude)
+		}
+
+		if crescent.Lag < test.MinLag || crescent.Lag > test.MaxLag {
+			t.Errorf("%s: want lag between %s and %s got %s\n", date, test.MinLag, test.MaxLag, crescent.Lag)
+		}
+
+		if crescent.Sunset.Day() != test.Date.Day() || crescent.Sunset.Sub(crescent.Conjunction) != crescent.Age {
+			t.Errorf("%s: invalid sunset %s\n", date, crescent.Sunset)
+		}
+
+		// Best time is four ninths of the lag after sunset
+		bestTime := crescent.Sunset.Add(crescent.Lag * 4 / 9)
+		if diff := crescent.BestTime.Sub(bestTime); diff < -time.Second || diff > time.Second {
+			t.Errorf("%s: want best time %s got %s\n", date, bestTime, crescent.BestTime)
+		}
+
+		if math.Abs(crescent.Elongation-crescent.ARCL) > 0.5 {
+			t.Errorf("%s: elongation %f is too far from ARCL %f\n", date, crescent.Elongation, crescent.ARCL)
+		}
+	}
+}
+
+func Test_Crescent_PolarNight(t *testing.T) {
+	// In Tromsø there is no sunset in the middle of June
+	tromso := astro.Observer{Latitude: 69.6492, Longitude: 18.9553}
+	if _, err := hijri.ObserveCrescent(time.Date(2025, 6, 26, 0, 0, 0, 0, time.UTC), tromso); err == nil {
+		t.Errorf("want error when the sun doesn't set\n")
+	}
+}
+
+func Test_Crescent_AzimuthNearNorth(t *testing.T) {
+	// In winter at 62° N the Sun goes past north at the best time, while the Moon is still a bit
+	// west of it, so the difference of azimuth must not be around -360°
+	obs := astro.Observer{Latitude: 62, Longitude: 10}
+	crescent, err := hijri.ObserveCrescent(time.Date(2023, 12, 13, 0, 0, 0, 0, time.UTC), obs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if crescent.DAZ < 15 || crescent.DAZ > 30 {
+		t.Errorf("want DAZ around 21° got %f\n", crescent.DAZ)
+	}
+}
+
+func Test_Crescent_ObserverModel(t *testing.T) {
+	date := time.Date(2023, 4, 20, 0, 0, 0, 0, time.UTC)
+	standard, err := hijri.ObserveCrescent(date, hijri.Jakarta)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// By default the apparent altitude is the upper limb with refraction
+	diff := standard.MoonApparentAltitude - standard.MoonAltitude
+	if diff < 0.5 || diff > 0.7 {
+		t.Errorf("default: want apparent altitude raised by 0.5-0.7° got %f\n", diff)
+	}
+
+	// On a hill, the actual horizon makes sunset later and the Moon lower, while its apparent
+	// altitude is raised by the dip and slightly more refraction
+	obs := hijri.Jakarta
+	obs.Elevation = 500
+	obs.Horizon = astro.ActualHorizon
+	hill, err := hijri.ObserveCrescent(date, obs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !hill.Sunset.After(standard.Sunset) || hill.MoonAltitude >= standard.MoonAltitude {
+		t.Errorf("actual horizon: want later sunset got %s and %s\n", hill.Sunset, standard.Sunset)
+	}
+
+	raised := hill.MoonApparentAltitude - hill.MoonAltitude
+	if expected := diff + obs.Dip(); math.Abs(raised-expected) > 0.1 {
+		t.Errorf("actual horizon: want apparent altitude raised by %f got %f\n", expected, raised)
+	}
+}
@@ -0,0 +1,148 @@
+package hijri
+
+import "time"
+
+// Criterion decides whether the crescent on an evening starts a new month. It's used by
+// CrescentCalendar, which only asks the criterion if the conjunction already happened before
+// sunset.
+type Criterion interface {
+	StartsMonth(crescent CrescentObservation) bool
+}
+
+// CriterionFunc is an adapter to allow the use of ordinary function as Criterion.
+type CriterionFunc func(crescent CrescentObservation) bool
+
+// StartsMonth calls f(crescent).
+func (f CriterionFunc) StartsMonth(crescent CrescentObservation) bool {
+	return f(crescent)
+}
+
+// Check is a set of thresholds checked by MinimumCriterion, combined using bitwise OR.
+type Check uint
+
+// Thresholds that can be checked by MinimumCriterion.
+const (
+	CheckAltitude Check = 1 << iota
+	CheckElongation
+	CheckGeocentricElongation
+	CheckAge
+	CheckLag
+	CheckIllumination
+
+	// CheckMoonset requires the Moon to set after the Sun, without any minimum value.
+	CheckMoonset
+)
+
+// MinimumCriterion is a criterion made of the minimum values that must be reached at sunset, which
+// covers most of the rules used by the councils and governments. Every minimum value that is not
+// zero is checked. Since zero can be a minimum value as well, e.g. altitude at least 0°, it's only
+// checked when its threshold is listed in Checks.
+type MinimumCriterion struct {
+	// Checks is the thresholds that must be passed even when their minimum value is zero, e.g.
+	// CheckAltitude for the Moon above horizon, or CheckMoonset which has no minimum value.
+	Checks Check
+
+	// MinAltitude is the minimum altitude of the Moon in degrees. By default it's the topocentric
+	// altitude of the Moon center without refraction, but if ApparentAltitude is true, it's the
+	// altitude using the observer's conventions of limb, refraction and horizon.
+	MinAltitude      float64
+	ApparentAltitude bool
+
+	// MinElongation and MinGeocentricElongation is the minimum topocentric and geocentric angular
+	// distance between the Sun and the Moon, in degrees.
+	MinElongation           float64
+	MinGeocentricElongation float64
+
+	// MinAge is the minimum duration between the conjunction and sunset, while MinLag is the
+	// minimum duration between sunset and moonset.
+	MinAge time.Duration
+	MinLag time.Duration
+
+	// MinIllumination is the minimum illuminated fraction of the Moon's disk, from 0 to 1.
+	MinIllumination float64
+}
+
+// StartsMonth returns true if the crescent passes every checked threshold.
+func (mc MinimumCriterion) StartsMonth(crescent CrescentObservation) bool {
+	altitude := crescent.MoonAltitude
+	if mc.ApparentAltitude {
+		altitude = crescent.MoonApparentAltitude
+	}
+
+	checks := mc.checks()
+	switch {
+	case checks&CheckAltitude != 0 && altitude < mc.MinAltitude,
+		checks&CheckElongation != 0 && crescent.Elongation < mc.MinElongation,
+		checks&CheckGeocentricElongation != 0 && crescent.GeocentricElongation < mc.MinGeocentricElongation,
+		checks&CheckAge != 0 && crescent.Age < mc.MinAge,
+		checks&CheckLag != 0 && crescent.Lag < mc.MinLag,
+		checks&CheckIllumination != 0 && crescent.Illumination < mc.MinIllumination,
+		checks&CheckMoonset != 0 && crescent.Lag <= 0:
+		return false
+	default:
+		return true
+	}
+}
+
+// checks returns the thresholds listed in Checks, plus the ones with non-zero minimum value.
+func (mc MinimumCriterion) checks() Check {
+	checks := mc.Checks
+	if mc.MinAltitude != 0 {
+		checks |= CheckAltitude
+	}
+
+	if mc.MinElongation != 0 {
+		checks |= CheckElongation
+	}
+
+	if mc.MinGeocentricElongation != 0 {
+		checks |= CheckGeocentricElongation
+	}
+
+	if mc.MinAge != 0 {
+		checks |= CheckAge
+	}
+
+	if mc.MinLag != 0 {
+		checks |= CheckLag
+	}
+
+	if mc.MinIllumination != 0 {
+		checks |= CheckIllumination
+	}
+
+	return checks
+}
+
+// VisibilityCriterion is a criterion where the crescent must be predicted visible by a visibility
+// test, e.g. YallopTest or OdehTest. Since it holds a function, its value is not comparable and
+// comparing two Criterion holding it panics, so use a pointer to it when it must be compared, as
+// YallopCriterion and OdehCriterion do.
+type VisibilityCriterion struct {
+	Test          func(CrescentObservation) VisibilityTest
+	MinVisibility Visibility
+}
+
+// StartsMonth returns true if the crescent visibility is at least the minimum visibility.
+func (vc VisibilityCriterion) StartsMonth(crescent CrescentObservation) bool {
+	return vc.Test(crescent).Visibility >= vc.MinVisibility
+}
+
+// Criteria that used by the built-in calendars.
+var (
+	// WujudulHilalCriterion requires the Moon to set after the Sun, as used by Muhammadiyah.
+	WujudulHilalCriterion Criterion = MinimumCriterion{Checks: CheckMoonset}
+
+	// MABIMSCriterion requires the altitude and geocentric elongation of neo-MABIMS rule.
+	MABIMSCriterion Criterion = MinimumCriterion{
+		Checks:                  CheckAltitude | CheckGeocentricElongation,
+		MinAltitude:             MABIMSMinAltitude,
+		MinGeocentricElongation: MABIMSMinElongation,
+	}
+
+	// YallopCriterion requires the crescent to be visible by naked eye according to Yallop.
+	YallopCriterion Criterion = &VisibilityCriterion{Test: YallopTest, MinVisibility: NakedEye}
+
+	// OdehCriterion requires the crescent to be visible by naked eye according to Odeh.
+	OdehCriterion Criterion = &VisibilityCriterion{Test: OdehTest, MinVisibility: NakedEye}
+)
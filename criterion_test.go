@@ -0,0 +1,121 @@
+package hijri_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_Criterion_Minimum(t *testing.T) {
+	crescent := hijri.CrescentObservation{
+		Age:                  10 * time.Hour,
+		Lag:                  20 * time.Minute,
+		MoonAltitude:         3.5,
+		MoonApparentAltitude: 4.1,
+		Elongation:           7.5,
+		GeocentricElongation: 8.2,
+		Illumination:         0.005,
+	}
+
+	tests := []struct {
+		Criterion hijri.MinimumCriterion
+		Expected  bool
+	}{
+		{hijri.MinimumCriterion{}, true},
+		{hijri.MinimumCriterion{MinAltitude: 4}, false},
+		{hijri.MinimumCriterion{MinAltitude: 3, MinAge: 8 * time.Hour}, true},
+		{hijri.MinimumCriterion{MinAltitude: 3, MinAge: 12 * time.Hour}, false},
+		{hijri.MinimumCriterion{Checks: hijri.CheckAltitude, MinAltitude: 4}, false},
+		{hijri.MinimumCriterion{Checks: hijri.CheckAltitude, MinAltitude: 4, ApparentAltitude: true}, true},
+		{hijri.MinimumCriterion{Checks: hijri.CheckElongation, MinElongation: 8}, false},
+		{hijri.MinimumCriterion{Checks: hijri.CheckGeocentricElongation, MinGeocentricElongation: 8}, true},
+		{hijri.MinimumCriterion{Checks: hijri.CheckAge | hijri.CheckLag, MinAge: 8 * time.Hour, MinLag: 20 * time.Minute}, true},
+		{hijri.MinimumCriterion{Checks: hijri.CheckAge, MinAge: 12 * time.Hour}, false},
+		{hijri.MinimumCriterion{Checks: hijri.CheckLag, MinLag: 30 * time.Minute}, false},
+		{hijri.MinimumCriterion{Checks: hijri.CheckIllumination, MinIllumination: 0.01}, false},
+		{hijri.MinimumCriterion{Checks: hijri.CheckMoonset | hijri.CheckAltitude, MinAltitude: 2}, true},
+	}
+
+	for i, test := range tests {
+		if result := test.Criterion.StartsMonth(crescent); result != test.Expected {
+			t.Errorf("%d: want %t got %t\n", i, test.Expected, result)
+		}
+	}
+
+	crescent.Lag = -time.Minute
+	if hijri.WujudulHilalCriterion.StartsMonth(crescent) {
+		t.Errorf("moon that sets before the Sun must fail Wujudul Hilal\n")
+	}
+
+	// Zero is a valid minimum value when it's checked
+	crescent.MoonAltitude = -0.5
+	aboveHorizon := hijri.MinimumCriterion{Checks: hijri.CheckAltitude}
+	if aboveHorizon.StartsMonth(crescent) {
+		t.Errorf("moon below the horizon must fail altitude at least 0°\n")
+	}
+}
+
+func Test_Criterion_CustomCalendar(t *testing.T) {
+	// Custom criterion with the same rule as the built-in one gives the same calendar
+	custom := hijri.NewCrescentCalendar(hijri.CriterionFunc(func(crescent hijri.CrescentObservation) bool {
+		return crescent.MoonAltitude >= 3 && crescent.GeocentricElongation >= 6.4
+	}), hijri.Jakarta)
+	builtin := hijri.NewMABIMSCalendar(hijri.Jakarta)
+
+	for month := int64(1); month <= 12; month++ {
+		expected, err := builtin.MonthStart(1445, month)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		result, err := custom.MonthStart(1445, month)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !result.Equal(expected) {
+			t.Errorf("1445-%02d: want %s got %s\n", month,
+				expected.Format("2006-01-02"), result.Format("2006-01-02"))
+		}
+	}
+
+	// Stricter criterion never starts a month earlier
+	strict := hijri.NewCrescentCalendar(hijri.MinimumCriterion{Checks: hijri.CheckAltitude, MinAltitude: 10}, hijri.Jakarta)
+	for month := int64(1); month <= 12; month++ {
+		expected, _ := builtin.MonthStart(1445, month)
+		result, err := strict.MonthStart(1445, month)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if result.Before(expected) {
+			t.Errorf("1445-%02d: want not before %s got %s\n", month,
+				expected.Format("2006-01-02"), result.Format("2006-01-02"))
+		}
+	}
+}
+
+func Test_Criterion_CreateDate(t *testing.T) {
+	// 10 April 2024 is 1 Shawwal 1445 H in Indonesia
+	cal := hijri.NewMABIMSCalendar(hijri.Jakarta)
+	for i := 0; i < 2; i++ {
+		date, err := cal.CreateDate(time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if date.Year != 1445 || date.Month != 10 || date.Day != 1 {
+			t.Errorf("want 1445-10-01 got %04d-%02d-%02d\n", date.Year, date.Month, date.Day)
+		}
+	}
+
+	if cal.Criterion() != hijri.MABIMSCriterion || cal.Observer() != hijri.Jakarta {
+		t.Errorf("calendar must keep its criterion and observer\n")
+	}
+
+	// Built-in visibility criteria can be compared as well
+	if hijri.NewYallopCalendar(hijri.Mecca).Criterion() != hijri.YallopCriterion {
+		t.Errorf("calendar must keep Yallop criterion\n")
+	}
+}
@@ -0,0 +1,223 @@
+package hijri
+
+import (
+	"errors"
+	"time"
+)
+
+// meanLunation is the average length of a synodic month in days.
+const meanLunation = 29.530588853
+
+// Date is a date in any Hijri calendar that implements Calendar interface.
+type Date struct {
+	Day      int64
+	Month    int64
+	Year     int64
+	Calendar Calendar
+}
+
+// CreateDate converts Gregorian date to Hijri date using the specified calendar. It will returns
+// error if the date is outside the scope of the calendar.
+func CreateDate(date time.Time, cal Calendar) (Date, error) {
+	// Calculate Julian Day Number
+	cjdn, err := timeToJDN(date)
+	if err != nil {
+		return Date{}, err
+	}
+
+	// Use the same month starts for the whole conversion, even if the calendar is changed meanwhile
+	dateCal := cal
+	cal = snapshotCalendar(cal)
+
+	// Estimate the lunation number (count of months since 1 Muharram 1 H) for this day
+	iln := int64(float64(cjdn-1948439)/meanLunation) + 1
+	if iln < 1 {
+		iln = 1
+	}
+
+	// The estimate might be a month or two off, which can be outside a calendar with limited
+	// scope like a table, even when the day itself is inside. In that case, start from the
+	// nearest lunation which covered by the calendar.
+	start, err := lunationStart(cal, iln)
+	for _, delta := range []int64{-1, 1, -2, 2} {
+		if err == nil || iln+delta < 1 {
+			continue
+		}
+
+		if nearStart, nearErr := lunationStart(cal, iln+delta); nearErr == nil {
+			iln, start, err = iln+delta, nearStart, nil
+		}
+	}
+
+	if err != nil {
+		return Date{}, err
+	}
+
+	// Adjust the lunation until its month contains the day. If the adjacent month is outside the
+	// calendar, the day is outside as well.
+
+	for start > cjdn {
+		if iln == 1 {
+			return Date{}, errors.New("date is before hijri calendar started")
+		}
+
+		iln--
+		if start, err = lunationStart(cal, iln); err != nil {
+			return Date{}, err
+		}
+	}
+
+	for {
+		nextStart, err := lunationStart(cal, iln+1)
+		if err != nil {
+			return Date{}, err
+		}
+
+		if nextStart > cjdn {
+			break
+		}
+
+		iln++
+		start = nextStart
+	}
+
+	year, month := lunationMonth(iln)
+	return Date{
+		Day:      cjdn - start + 1,
+		Month:    month,
+		Year:     year,
+		Calendar: dateCal,
+	}, nil
+}
+
+// ToGregorian convert Hijri date to Gregorian date using Golang standard time. If the date is
+// outside the scope of its calendar, it will returns zero time.
+func (d Date) ToGregorian() time.Time {
+	cjdn, err := d.julianDayNumber()
+	if err != nil {
+		return time.Time{}
+	}
+
+	return jdnToTime(cjdn)
+}
+
+// Validate checks whether the date exists in its calendar, i.e. it has a calendar, the month is
+// between 1 and 12, and the day is within the length of the month. It returns error as well if
+// the month is outside the scope of the calendar.
+func (d Date) Validate() error {
+	if d.Calendar == nil {
+		return errors.New("date has no calendar")
+	}
+
+	if d.Month < 1 || d.Month > 12 {
+		return errors.New("month must be between 1 and 12")
+	}
+
+	cal := snapshotCalendar(d.Calendar)
+	iln := lunationNumber(d.Year, d.Month)
+	start, err := lunationStart(cal, iln)
+	if err != nil {
+		return err
+	}
+
+	nextStart, err := lunationStart(cal, iln+1)
+	if err != nil {
+		return err
+	}
+
+	if d.Day < 1 || d.Day > nextStart-start {
+		return errors.New("day is outside the month")
+	}
+
+	return nil
+}
+
+// Weekday returns the day of the week of this Hijri date. If the date is outside the scope of its
+// calendar or it has no calendar, it will returns Sunday, so use Validate to check the date first.
+func (d Date) Weekday() time.Weekday {
+	weekday, _ := d.weekday()
+	return weekday
+}
+
+// YearDay returns the day of the year of this Hijri date, in the range [1, 355]. If the date is
+// outside the scope of its calendar or it has no calendar, it will returns zero.
+func (d Date) YearDay() int64 {
+	yearDay, _ := d.yearDay()
+	return yearDay
+}
+
+// Week returns the week number of this Hijri date, in the range [1, 52]. The first week of
+// the year is the week that contains 1 Muharram, and every week begins on the specified weekday
+// (usually Saturday, Sunday or Monday). Like YearDay, it returns zero for invalid date.
+func (d Date) Week(firstDay time.Weekday) int64 {
+	yearDay, err := d.yearDay()
+	if err != nil {
+		return 0
+	}
+
+	weekday, _ := d.weekday()
+	return yearWeek(yearDay, weekday, firstDay)
+}
+
+func (d Date) weekday() (time.Weekday, error) {
+	cjdn, err := d.julianDayNumber()
+	if err != nil {
+		return time.Sunday, err
+	}
+
+	return jdnWeekday(cjdn), nil
+}
+
+func (d Date) yearDay() (int64, error) {
+	if d.Calendar == nil {
+		return 0, errors.New("date has no calendar")
+	}
+
+	d.Calendar = snapshotCalendar(d.Calendar)
+	cjdn, err := d.julianDayNumber()
+	if err != nil {
+		return 0, err
+	}
+
+	newYear, err := lunationStart(d.Calendar, lunationNumber(d.Year, 1))
+	if err != nil {
+		return 0, err
+	}
+
+	return cjdn - newYear + 1, nil
+}
+
+func (d Date) julianDayNumber() (int64, error) {
+	if d.Calendar == nil {
+		return 0, errors.New("date has no calendar")
+	}
+
+	start, err := lunationStart(d.Calendar, lunationNumber(d.Year, d.Month))
+	if err != nil {
+		return 0, err
+	}
+
+	return start + d.Day - 1, nil
+}
+
+// lunationNumber returns the count of months since 1 Muharram 1 H, with the first month as 1.
+func lunationNumber(year, month int64) int64 {
+	return 12*(year-1) + month
+}
+
+// lunationMonth converts lunation number back to its Hijri year and month.
+func lunationMonth(iln int64) (year, month int64) {
+	year = (iln-1)/12 + 1
+	month = (iln-1)%12 + 1
+	return
+}
+
+// lunationStart returns the Julian Day Number of the first day in the lunation.
+func lunationStart(cal Calendar, iln int64) (int64, error) {
+	start, err := cal.MonthStart(lunationMonth(iln))
+	if err != nil {
+		return 0, err
+	}
+
+	return timeToJDN(start)
+}
@@ -0,0 +1,105 @@
+package hijri_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_Date_ConvertDate(t *testing.T) {
+	date := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxDate := time.Date(2051, 1, 1, 0, 0, 0, 0, time.UTC)
+	for date.Before(maxDate) {
+		// Compare with the dedicated date types
+		hijriDate, _ := hijri.CreateHijriDate(date, hijri.Default)
+		ummAlQuraDate, _ := hijri.CreateUmmAlQuraDate(date)
+		tabularDate, err := hijri.CreateDate(date, hijri.Default)
+		if err != nil {
+			t.Fatalf("%s: %v\n", date.Format("2006-01-02"), err)
+		}
+
+		uqDate, err := hijri.CreateDate(date, hijri.UmmAlQura)
+		if err != nil {
+			t.Fatalf("%s: %v\n", date.Format("2006-01-02"), err)
+		}
+
+		if tabularDate.Year != hijriDate.Year || tabularDate.Month != hijriDate.Month || tabularDate.Day != hijriDate.Day {
+			t.Errorf("%s: want %v got %v\n", date.Format("2006-01-02"), hijriDate, tabularDate)
+		}
+
+		if uqDate.Year != ummAlQuraDate.Year || uqDate.Month != ummAlQuraDate.Month || uqDate.Day != ummAlQuraDate.Day {
+			t.Errorf("%s: want %v got %v\n", date.Format("2006-01-02"), ummAlQuraDate, uqDate)
+		}
+
+		// Convert it back to Gregorian
+		if !uqDate.ToGregorian().Equal(date) {
+			t.Errorf("%s: got %s\n", date.Format("2006-01-02"), uqDate.ToGregorian().Format("2006-01-02"))
+		}
+
+		if uqDate.Weekday() != date.Weekday() || uqDate.YearDay() != ummAlQuraDate.YearDay() {
+			t.Errorf("%s: wrong weekday or year day\n", date.Format("2006-01-02"))
+		}
+
+		date = date.AddDate(0, 0, 1)
+	}
+}
+
+func Test_Date_OutOfScope(t *testing.T) {
+	dates := []time.Time{
+		time.Date(1937, 3, 13, 0, 0, 0, 0, time.UTC),
+		time.Date(2077, 11, 17, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, date := range dates {
+		if _, err := hijri.CreateDate(date, hijri.UmmAlQura); err == nil {
+			t.Errorf("%s: want error got nil\n", date.Format("2006-01-02"))
+		}
+	}
+
+	if _, err := hijri.CreateDate(time.Date(622, 7, 15, 0, 0, 0, 0, time.UTC), hijri.Default); err == nil {
+		t.Error("622-07-15: want error got nil")
+	}
+
+	invalid := hijri.Date{Day: 1, Month: 1, Year: 1600, Calendar: hijri.UmmAlQura}
+	if !invalid.ToGregorian().IsZero() {
+		t.Error("1600-01-01: want zero time")
+	}
+}
+
+func Test_Date_Validate(t *testing.T) {
+	tests := []struct {
+		Date  hijri.Date
+		Valid bool
+	}{
+		{hijri.Date{Day: 30, Month: 9, Year: 1444, Calendar: hijri.UmmAlQura}, false},
+		{hijri.Date{Day: 29, Month: 9, Year: 1444, Calendar: hijri.UmmAlQura}, true},
+		{hijri.Date{Day: 30, Month: 12, Year: 1445, Calendar: hijri.Default}, true},
+		{hijri.Date{Day: 1, Month: 13, Year: 1445, Calendar: hijri.Default}, false},
+		{hijri.Date{Day: 0, Month: 1, Year: 1445, Calendar: hijri.Default}, false},
+		{hijri.Date{Day: 1, Month: 1, Year: 1600, Calendar: hijri.UmmAlQura}, false},
+		{hijri.Date{Day: 1, Month: 1, Year: 1447}, false},
+	}
+
+	for _, test := range tests {
+		if err := test.Date.Validate(); (err == nil) != test.Valid {
+			t.Errorf("%d-%02d-%02d: want valid %v got %v\n",
+				test.Date.Year, test.Date.Month, test.Date.Day, test.Valid, err)
+		}
+	}
+
+	// Date without calendar doesn't have weekday and day of year
+	noCalendar := hijri.Date{Day: 1, Month: 1, Year: 1447}
+	if noCalendar.YearDay() != 0 || noCalendar.Week(time.Sunday) != 0 {
+		t.Errorf("want zero year day and week got %d and %d\n", noCalendar.YearDay(), noCalendar.Week(time.Sunday))
+	}
+
+	if err := noCalendar.Validate(); err == nil {
+		t.Errorf("date without calendar: want error got nil\n")
+	}
+
+	expected := "?, 1 Muharram 1447 AH (?)"
+	if result := noCalendar.Format(hijri.LayoutLong + " (002)"); result != expected {
+		t.Errorf("want %q got %q\n", expected, result)
+	}
+}
@@ -0,0 +1,426 @@
+package hijri
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Condition is a single requirement checked when deciding whether an evening starts a new month.
+type Condition struct {
+	// Name is the checked quantity, e.g. "altitude" or "elongation".
+	Name string
+
+	// Value is the observed value and Threshold is the minimum value it must reach, both in Unit,
+	// which is "°" for angles, "h" for hours, "min" for minutes or empty for plain numbers.
+	Value     float64
+	Threshold float64
+	Unit      string
+
+	// Note is the additional explanation, e.g. the visibility zone.
+	Note string
+
+	Passed bool
+}
+
+// ConditionCriterion is a Criterion that can list the conditions it checks, which used to explain
+// the month start decision. Criterion that doesn't implement it will only explained by its result.
+type ConditionCriterion interface {
+	Criterion
+	Conditions(crescent CrescentObservation) []Condition
+}
+
+// MonthDecision is the trace of how a computed calendar decides the start of a Hijri month.
+type MonthDecision struct {
+	Year        int64
+	Month       int64
+	Conjunction time.Time
+	Evenings    []EveningDecision
+
+	// Start is the first day of the month. If the crescent didn't pass on the evening of the
+	// conjunction day, the month starts two days after that day and Completed is true.
+	Start     time.Time
+	Completed bool
+}
+
+// EveningDecision is the decision for the crescent on an evening at a location.
+type EveningDecision struct {
+	// Date is the local date of the evening.
+	Date time.Time
+
+	// Crescent is the circumstance of the crescent, including the location where it's observed.
+	Crescent   CrescentObservation
+	Conditions []Condition
+	Passed     bool
+}
+
+// Conditions returns every threshold checked by the criterion.
+func (mc MinimumCriterion) Conditions(crescent CrescentObservation) []Condition {
+	var conditions []Condition
+	checks := mc.checks()
+	if checks&CheckAltitude != 0 {
+		name, altitude := "altitude", crescent.MoonAltitude
+		if mc.ApparentAltitude {
+			name, altitude = "apparent altitude", crescent.MoonApparentAltitude
+		}
+		conditions = append(conditions, minimumCondition(name, altitude, mc.MinAltitude, "°"))
+	}
+
+	if checks&CheckElongation != 0 {
+		conditions = append(conditions, minimumCondition("elongation",
+			crescent.Elongation, mc.MinElongation, "°"))
+	}
+
+	if checks&CheckGeocentricElongation != 0 {
+		conditions = append(conditions, minimumCondition("geocentric elongation",
+			crescent.GeocentricElongation, mc.MinGeocentricElongation, "°"))
+	}
+
+	if checks&CheckAge != 0 {
+		conditions = append(conditions, minimumCondition("age",
+			crescent.Age.Hours(), mc.MinAge.Hours(), "h"))
+	}
+
+	if checks&CheckLag != 0 {
+		conditions = append(conditions, minimumCondition("lag",
+			crescent.Lag.Minutes(), mc.MinLag.Minutes(), "min"))
+	}
+
+	if checks&CheckIllumination != 0 {
+		conditions = append(conditions, minimumCondition("illumination",
+			crescent.Illumination, mc.MinIllumination, ""))
+	}
+
+	if checks&CheckMoonset != 0 {
+		condition := minimumCondition("lag", crescent.Lag.Minutes(), 0, "min")
+		condition.Note = "moonset after sunset"
+		condition.Passed = crescent.Lag > 0
+		conditions = append(conditions, condition)
+	}
+
+	return conditions
+}
+
+// Conditions returns the visibility predicted by the test.
+func (vc VisibilityCriterion) Conditions(crescent CrescentObservation) []Condition {
+	test := vc.Test(crescent)
+	return []Condition{{
+		Name:   "visibility",
+		Value:  test.Value,
+		Note:   fmt.Sprintf("zone %s is %s, requires %s", test.Zone, test.Visibility, vc.MinVisibility),
+		Passed: test.Visibility >= vc.MinVisibility,
+	}}
+}
+
+// Explain returns the trace of how the calendar decides the start of the specified Hijri month.
+func (cc *CrescentCalendar) Explain(year, month int64) (MonthDecision, error) {
+	if year < 1 {
+		return MonthDecision{}, errors.New("year must be greater than zero")
+	}
+
+	if month < 1 || month > 12 {
+		return MonthDecision{}, errors.New("month must be between 1 and 12")
+	}
+
+	// Find the local date of conjunction
+	conjunction := Conjunction(year, month)
+	local := conjunction.In(observerLocation(cc.observer))
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+
+	decision := MonthDecision{
+		Year:        year,
+		Month:       month,
+		Conjunction: conjunction,
+		Start:       day.AddDate(0, 0, 2),
+		Completed:   true,
+	}
+
+	// Check the crescent on the evening of conjunction. The next evening doesn't need to be checked,
+	// since whether it passes or not the month starts on the same day.
+	crescent, err := ObserveCrescent(day, cc.observer)
+	if err != nil {
+		return MonthDecision{}, err
+	}
+
+	result := EveningDecision{
+		Date:       day,
+		Crescent:   crescent,
+		Conditions: []Condition{conjunctionCondition(crescent)},
+		Passed:     crescent.Age > 0 && cc.criterion.StartsMonth(crescent),
+	}
+
+	if explainer, ok := cc.criterion.(ConditionCriterion); ok {
+		result.Conditions = append(result.Conditions, explainer.Conditions(crescent)...)
+	}
+
+	decision.Evenings = append(decision.Evenings, result)
+	if result.Passed {
+		decision.Start = day.AddDate(0, 0, 1)
+		decision.Completed = false
+	}
+
+	return decision, nil
+}
+
+// Explain returns the trace of how the calendar decides the start of the specified Hijri month.
+// For each evening, the crescent is observed at the first location that passed the criterion, or
+// at the location with the highest Moon if none of them passed. If the sunset doesn't happen after
+// the conjunction anywhere in the checked area, the evening fails without any location.
+func (uc *UnifiedCalendar) Explain(year, month int64) (MonthDecision, error) {
+	if year < 1 {
+		return MonthDecision{}, errors.New("year must be greater than zero")
+	}
+
+	if month < 1 || month > 12 {
+		return MonthDecision{}, errors.New("month must be between 1 and 12")
+	}
+
+	conjunction := Conjunction(year, month)
+	utc := conjunction.UTC()
+	day := time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
+
+	decision := MonthDecision{
+		Year:        year,
+		Month:       month,
+		Conjunction: conjunction,
+		Start:       day.AddDate(0, 0, 2),
+		Completed:   true,
+	}
+
+	// Like CrescentCalendar, only the evening of conjunction day affects the month start
+	result := uc.checkWorld(day, conjunction)
+	if !result.found {
+		decision.Evenings = append(decision.Evenings, EveningDecision{
+			Date:     day,
+			Crescent: CrescentObservation{Conjunction: conjunction},
+			Conditions: []Condition{{
+				Name: "sunset after conjunction",
+				Note: "nowhere in the checked area",
+			}},
+		})
+		return decision, nil
+	}
+
+	crescent, err := ObserveCrescent(day, result.observer)
+	if err != nil {
+		return MonthDecision{}, err
+	}
+
+	criterion := MinimumCriterion{
+		Checks:                  CheckAltitude | CheckGeocentricElongation,
+		MinAltitude:             uc.minAltitude,
+		MinGeocentricElongation: uc.minElongation,
+	}
+
+	conditions := append([]Condition{conjunctionCondition(crescent)}, criterion.Conditions(crescent)...)
+	midnight := day.AddDate(0, 0, 1)
+	deadline := minimumCondition("sunset before 00:00 UTC", midnight.Sub(crescent.Sunset).Hours(), 0, "h")
+	deadline.Passed = crescent.Sunset.Before(midnight)
+	conditions = append(conditions, deadline)
+
+	// After 00:00 UTC, the crescent only counts on the Americas mainland if the conjunction
+	// happened before dawn in New Zealand
+	if !deadline.Passed {
+		exception := Condition{Name: "conjunction before dawn in New Zealand", Unit: "h"}
+		if !result.nzDawn.IsZero() {
+			exception.Value = result.nzDawn.Sub(conjunction).Hours()
+		}
+
+		exception.Passed = result.exception
+		switch {
+		case result.exception:
+			exception.Note = "Americas exception, crescent reached on the Americas mainland"
+		case exception.Value > 0:
+			exception.Note = "Americas exception, crescent not reached on the Americas mainland"
+		default:
+			exception.Note = "Americas exception"
+		}
+		conditions = append(conditions, exception)
+	}
+
+	decision.Evenings = append(decision.Evenings, EveningDecision{
+		Date:       day,
+		Crescent:   crescent,
+		Conditions: conditions,
+		Passed:     result.passed,
+	})
+
+	if result.passed {
+		decision.Start = midnight
+		decision.Completed = false
+	}
+
+	return decision, nil
+}
+
+// MarshalJSON encodes the decision into JSON.
+func (md MonthDecision) MarshalJSON() ([]byte, error) {
+	type jsonCondition struct {
+		Name      string  `json:"name"`
+		Value     float64 `json:"value"`
+		Threshold float64 `json:"threshold"`
+		Unit      string  `json:"unit,omitempty"`
+		Note      string  `json:"note,omitempty"`
+		Passed    bool    `json:"passed"`
+	}
+
+	type jsonEvening struct {
+		Date         string          `json:"date"`
+		Latitude     float64         `json:"latitude"`
+		Longitude    float64         `json:"longitude"`
+		Elevation    float64         `json:"elevation"`
+		Sunset       string          `json:"sunset"`
+		Moonset      string          `json:"moonset"`
+		Lag          float64         `json:"lag_minutes"`
+		Age          float64         `json:"age_hours"`
+		Altitude     float64         `json:"altitude"`
+		Elongation   float64         `json:"elongation"`
+		Illumination float64         `json:"illumination"`
+		Conditions   []jsonCondition `json:"conditions"`
+		Passed       bool            `json:"passed"`
+	}
+
+	evenings := make([]jsonEvening, len(md.Evenings))
+	for i, ed := range md.Evenings {
+		c := ed.Crescent
+		evenings[i] = jsonEvening{
+			Date:         ed.Date.Format("2006-01-02"),
+			Latitude:     c.Observer.Latitude,
+			Longitude:    c.Observer.Longitude,
+			Elevation:    c.Observer.Elevation,
+			Sunset:       c.Sunset.UTC().Format(time.RFC3339),
+			Moonset:      c.Moonset.UTC().Format(time.RFC3339),
+			Lag:          roundTo(c.Lag.Minutes(), 2),
+			Age:          roundTo(c.Age.Hours(), 2),
+			Altitude:     roundTo(c.MoonAltitude, 4),
+			Elongation:   roundTo(c.Elongation, 4),
+			Illumination: roundTo(c.Illumination, 6),
+			Passed:       ed.Passed,
+		}
+
+		for _, condition := range ed.Conditions {
+			evenings[i].Conditions = append(evenings[i].Conditions, jsonCondition{
+				Name:      condition.Name,
+				Value:     roundTo(condition.Value, 4),
+				Threshold: condition.Threshold,
+				Unit:      condition.Unit,
+				Note:      condition.Note,
+				Passed:    condition.Passed,
+			})
+		}
+	}
+
+	return json.Marshal(struct {
+		Year        int64         `json:"year"`
+		Month       int64         `json:"month"`
+		Conjunction string        `json:"conjunction"`
+		Evenings    []jsonEvening `json:"evenings"`
+		Start       string        `json:"start"`
+		Completed   bool          `json:"completed"`
+	}{
+		Year:        md.Year,
+		Month:       md.Month,
+		Conjunction: md.Conjunction.UTC().Format(time.RFC3339),
+		Evenings:    evenings,
+		Start:       md.Start.Format("2006-01-02"),
+		Completed:   md.Completed,
+	})
+}
+
+// WriteText writes the decision as plain text, with time in local mean time of each location and
+// angles in degrees, minutes and seconds.
+func (md MonthDecision) WriteText(w io.Writer) error {
+	ew := &errWriter{w: w}
+	ew.printf("Hijri month  : %04d-%02d\n", md.Year, md.Month)
+	ew.printf("Conjunction  : %s\n", md.Conjunction.UTC().Format("2006-01-02 15:04:05 MST"))
+	for _, ed := range md.Evenings {
+		c := ed.Crescent
+		if c.Sunset.IsZero() {
+			ew.printf("\nEvening of %s without location to observe\n", ed.Date.Format("2006-01-02"))
+		} else {
+			location := observerLocation(c.Observer)
+			ew.printf("\nEvening of %s at %.4f, %.4f\n", ed.Date.Format("2006-01-02"),
+				c.Observer.Latitude, c.Observer.Longitude)
+			ew.printf("  Sunset     : %s\n", c.Sunset.In(location).Format("15:04:05 LMT"))
+			ew.printf("  Moonset    : %s\n", c.Moonset.In(location).Format("15:04:05 LMT"))
+			ew.printf("  Age        : %s\n", formatDuration(c.Age))
+			ew.printf("  Lag        : %s\n", formatDuration(c.Lag))
+			ew.printf("  Altitude   : %s\n", formatDMS(c.MoonAltitude))
+			ew.printf("  Elongation : %s\n", formatDMS(c.Elongation))
+		}
+
+		for _, condition := range ed.Conditions {
+			ew.printf("  %s\n", condition)
+		}
+
+		result := "failed"
+		if ed.Passed {
+			result = "passed"
+		}
+		ew.printf("  Result     : %s\n", result)
+	}
+
+	reason := "crescent passed the criterion"
+	if md.Completed {
+		reason = "crescent didn't pass, two days after conjunction day"
+	}
+
+	ew.printf("\nMonth start  : %s (%s)\n", md.Start.Format("2006-01-02"), reason)
+	return ew.err
+}
+
+// String returns the decision as plain text.
+func (md MonthDecision) String() string {
+	buffer := bytes.NewBuffer(nil)
+	md.WriteText(buffer)
+	return buffer.String()
+}
+
+// String returns the condition in a single line, e.g. "[pass] altitude +3°12'05" >= +3°00'00"".
+func (c Condition) String() string {
+	status := "[fail]"
+	if c.Passed {
+		status = "[pass]"
+	}
+
+	var text string
+	switch c.Unit {
+	case "°":
+		text = fmt.Sprintf("%s %s %s >= %s", status, c.Name, formatDMS(c.Value), formatDMS(c.Threshold))
+	case "":
+		text = fmt.Sprintf("%s %s %.4f", status, c.Name, c.Value)
+		if c.Threshold != 0 {
+			text += fmt.Sprintf(" >= %.4f", c.Threshold)
+		}
+	default:
+		text = fmt.Sprintf("%s %s %.2f %s >= %.2f %s", status, c.Name, c.Value, c.Unit, c.Threshold, c.Unit)
+	}
+
+	if c.Note != "" {
+		text += " (" + c.Note + ")"
+	}
+
+	return text
+}
+
+func minimumCondition(name string, value, threshold float64, unit string) Condition {
+	return Condition{
+		Name:      name,
+		Value:     value,
+		Threshold: threshold,
+		Unit:      unit,
+		Passed:    value >= threshold,
+	}
+}
+
+// conjunctionCondition checks the conjunction happened before sunset, which required by every
+// computed calendar.
+func conjunctionCondition(crescent CrescentObservation) Condition {
+	condition := minimumCondition("age", crescent.Age.Hours(), 0, "h")
+	condition.Note = "conjunction before sunset"
+	condition.Passed = crescent.Age > 0
+	return condition
+}
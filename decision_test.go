@@ -0,0 +1,154 @@
+package hijri_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_Decision_Crescent(t *testing.T) {
+	// In Jakarta the crescent on 20 April 2023 was too low for MABIMS, so 1 Shawwal 1444 H
+	// started two days later on 22 April 2023
+	decision, err := hijri.NewMABIMSCalendar(hijri.Jakarta).Explain(1444, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if start := decision.Start.Format("2006-01-02"); start != "2023-04-22" || !decision.Completed {
+		t.Errorf("want completed month with start 2023-04-22 got %s\n", start)
+	}
+
+	if len(decision.Evenings) != 1 {
+		t.Fatalf("want 1 evening got %d\n", len(decision.Evenings))
+	}
+
+	first := decision.Evenings[0]
+	if first.Passed || first.Crescent.Observer != hijri.Jakarta {
+		t.Errorf("want the evening failed in Jakarta\n")
+	}
+
+	// Conjunction happened before sunset, but the altitude and elongation failed
+	expected := []struct {
+		Name   string
+		Passed bool
+	}{{"age", true}, {"altitude", false}, {"geocentric elongation", false}}
+	if len(first.Conditions) != len(expected) {
+		t.Fatalf("want %d conditions got %d\n", len(expected), len(first.Conditions))
+	}
+
+	for i, condition := range first.Conditions {
+		if condition.Name != expected[i].Name || condition.Passed != expected[i].Passed {
+			t.Errorf("%d: want %s %t got %s %t\n", i, expected[i].Name, expected[i].Passed,
+				condition.Name, condition.Passed)
+		}
+	}
+
+	if threshold := first.Conditions[1].Threshold; threshold != hijri.MABIMSMinAltitude {
+		t.Errorf("want altitude threshold %d got %f\n", hijri.MABIMSMinAltitude, threshold)
+	}
+}
+
+func Test_Decision_Completed(t *testing.T) {
+	// Criterion without conditions is only explained by its result
+	never := hijri.CriterionFunc(func(hijri.CrescentObservation) bool { return false })
+	decision, err := hijri.NewCrescentCalendar(never, hijri.Mecca).Explain(1445, 9)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !decision.Completed || len(decision.Evenings) != 1 {
+		t.Fatalf("want completed month after 1 evening got %d\n", len(decision.Evenings))
+	}
+
+	for _, evening := range decision.Evenings {
+		if evening.Passed || len(evening.Conditions) != 1 {
+			t.Errorf("%s: want only conjunction condition\n", evening.Date.Format("2006-01-02"))
+		}
+	}
+
+	start := decision.Evenings[0].Date.AddDate(0, 0, 2)
+	if !decision.Start.Equal(start) {
+		t.Errorf("want start %s got %s\n", start, decision.Start)
+	}
+
+	if text := decision.String(); !strings.Contains(text, "two days after conjunction day") {
+		t.Errorf("text decision has no fallback reason:\n%s", text)
+	}
+}
+
+func Test_Decision_Output(t *testing.T) {
+	decision, err := hijri.NewOdehCalendar(hijri.Mecca).Explain(1444, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(decision)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded struct {
+		Start    string
+		Evenings []struct {
+			Sunset     string
+			Passed     bool
+			Conditions []map[string]interface{}
+		}
+	}
+
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.Start != "2023-04-22" || len(decoded.Evenings) != 1 || decoded.Evenings[0].Sunset == "" {
+		t.Fatalf("invalid JSON decision %s\n", data)
+	}
+
+	if conditions := decoded.Evenings[0].Conditions; len(conditions) != 2 || conditions[1]["name"] != "visibility" {
+		t.Errorf("invalid JSON conditions %v\n", conditions)
+	}
+
+	text := decision.String()
+	for _, line := range []string{"Hijri month  : 1444-10", "[fail] visibility", "Month start  : 2023-04-22"} {
+		if !strings.Contains(text, line) {
+			t.Errorf("text decision has no %q\n", line)
+		}
+	}
+}
+
+func Test_Decision_Global(t *testing.T) {
+	// Shawwal 1447 H in KHGT started on 20 March 2026 thanks to the Americas exception
+	decision, err := hijri.KHGT.Explain(1447, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if start := decision.Start.Format("2006-01-02"); start != "2026-03-20" {
+		t.Errorf("want start 2026-03-20 got %s\n", start)
+	}
+
+	evening := decision.Evenings[len(decision.Evenings)-1]
+	if !evening.Passed || evening.Crescent.Observer.Longitude > -30 {
+		t.Errorf("want passed in the Americas got %f\n", evening.Crescent.Observer.Longitude)
+	}
+
+	// Sunset there is after 00:00 UTC, so the deadline failed but the exception passed
+	n := len(evening.Conditions)
+	deadline, exception := evening.Conditions[n-2], evening.Conditions[n-1]
+	if deadline.Passed || !exception.Passed || !strings.Contains(exception.Note, "Americas") {
+		t.Errorf("want deadline failed and Americas exception passed got %s and %s\n", deadline, exception)
+	}
+
+	// When sunset is before 00:00 UTC, the exception isn't listed
+	decision, err = hijri.KHGT.Explain(1446, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	evening = decision.Evenings[0]
+	if last := evening.Conditions[len(evening.Conditions)-1]; !last.Passed || last.Name != "sunset before 00:00 UTC" {
+		t.Errorf("want deadline passed got %s\n", last)
+	}
+}
@@ -24,5 +24,17 @@
 //
 // The implementation of Umm al-Qura calendar in this package is based on Javascript code by R.H. van Gent
 // from Utrecht University. The date must be between 14 March 1937 (1 Muharram 1356 H) and 16 November 2077
-// (29 Dhu al-Hijjah 1500 H).
+// (29 Dhu al-Hijjah 1500 H). Outside that range, UmmAlQuraExtended calculates the month starts using
+// the astronomical rule of Umm al-Qura.
+//
+// Both calendars implement Calendar interface, which only needs to tell when each month started. Other
+// calendars, like TableCalendar which loaded from month starts published by a local authority, can
+// implement it as well and then used through CreateDate and Date.
+//
+// There are also computed calendars, which month starts are decided by astronomical calculation from
+// package astro. For example CrescentCalendar starts a month after the evening when the crescent is
+// predicted to be visible at a location, according to criteria like Yallop's q-test or Odeh's V, or
+// by the rules used in Southeast Asia like Wujudul Hilal and MABIMS. UnifiedCalendar instead checks the
+// crescent over the whole world, which used by the global calendar like KHGT, while FCNA only needs the
+// time of conjunction.
 package hijri
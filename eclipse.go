@@ -0,0 +1,70 @@
+package hijri
+
+import (
+	"time"
+
+	"github.com/hablullah/go-hijri/astro"
+)
+
+// Eclipse is a solar or lunar eclipse labelled with the Hijri dates of its maximum. The visibility
+// for a location can be checked using Local method from the embedded astro.Eclipse.
+type Eclipse struct {
+	astro.Eclipse
+
+	// UmmAlQura is the Umm al-Qura date of the maximum in UTC. Since Umm al-Qura calendar is only
+	// available for a limited range, it will be zero for eclipse outside of that range.
+	UmmAlQura UmmAlQuraDate
+
+	// Hijri is the arithmetic Hijri date of the maximum in UTC, using the default leap years.
+	Hijri HijriDate
+}
+
+// Eclipses returns all solar and lunar eclipses which maximum happened between start and end,
+// sorted by time.
+func Eclipses(start, end time.Time) ([]Eclipse, error) {
+	var eclipses []Eclipse
+	for _, e := range astro.Eclipses(start, end) {
+		eclipse, err := labelEclipse(e)
+		if err != nil {
+			return nil, err
+		}
+		eclipses = append(eclipses, eclipse)
+	}
+
+	return eclipses, nil
+}
+
+// HijriEclipses returns all solar and lunar eclipses between the start of fromYear and the end of
+// toYear, using the specified calendar to find when the years started.
+func HijriEclipses(cal Calendar, fromYear, toYear int64) ([]Eclipse, error) {
+	start, err := YearStart(cal, fromYear)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := YearStart(cal, toYear+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return Eclipses(start, end)
+}
+
+func labelEclipse(e astro.Eclipse) (Eclipse, error) {
+	utc := e.Maximum.UTC()
+	date := time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
+
+	hijriDate, err := CreateHijriDate(date, Default)
+	if err != nil {
+		return Eclipse{}, err
+	}
+
+	// Umm al-Qura table is limited, so just leave it empty when out of range
+	ummAlQuraDate, _ := CreateUmmAlQuraDate(date)
+
+	return Eclipse{
+		Eclipse:   e,
+		UmmAlQura: ummAlQuraDate,
+		Hijri:     hijriDate,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package hijri_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+	"github.com/hablullah/go-hijri/astro"
+)
+
+func Test_Eclipse_HijriDates(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	eclipses, err := hijri.Eclipses(start, end)
+	if err != nil {
+		t.Fatalf("failed to list eclipses: %v\n", err)
+	}
+
+	if len(eclipses) != 8 {
+		t.Fatalf("want 8 eclipses got %d\n", len(eclipses))
+	}
+
+	// Total solar eclipse on 8 April 2024 happened at the end of Ramadan 1445
+	solar := eclipses[1]
+	expected := hijri.UmmAlQuraDate{Year: 1445, Month: 9, Day: 29}
+	if solar.Body != astro.Sun || solar.UmmAlQura != expected {
+		t.Errorf("solar eclipse: want %v got %v\n", expected, solar.UmmAlQura)
+	}
+
+	// Total lunar eclipse on 7 September 2025 happened in the middle of Rabi' al-Awwal 1447
+	lunar := eclipses[6]
+	expected = hijri.UmmAlQuraDate{Year: 1447, Month: 3, Day: 15}
+	if lunar.Body != astro.Moon || lunar.UmmAlQura != expected {
+		t.Errorf("lunar eclipse: want %v got %v\n", expected, lunar.UmmAlQura)
+	}
+
+	tabular, _ := hijri.CreateHijriDate(time.Date(2025, 9, 7, 0, 0, 0, 0, time.UTC), hijri.Default)
+	if lunar.Hijri != tabular {
+		t.Errorf("lunar eclipse: want %v got %v\n", tabular, lunar.Hijri)
+	}
+
+	if local := lunar.Local(hijri.Mecca); !local.Visible {
+		t.Errorf("lunar eclipse: want visible in Mecca\n")
+	}
+}
+
+func Test_Eclipse_HijriYears(t *testing.T) {
+	eclipses, err := hijri.HijriEclipses(hijri.UmmAlQura, 1446, 1446)
+	if err != nil {
+		t.Fatalf("failed to list eclipses: %v\n", err)
+	}
+
+	// Year 1446 started on 7 July 2024 and ended on 25 June 2025
+	if len(eclipses) != 4 {
+		t.Fatalf("want 4 eclipses got %d\n", len(eclipses))
+	}
+
+	for _, eclipse := range eclipses {
+		if eclipse.UmmAlQura.Year != 1446 {
+			t.Errorf("%s: want year 1446 got %d\n", eclipse.Maximum, eclipse.UmmAlQura.Year)
+		}
+	}
+
+	// Eclipse outside of Umm al-Qura table is still listed, only with the arithmetic date
+	old, err := hijri.Eclipses(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1901, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil || len(old) == 0 {
+		t.Fatalf("want eclipses in 1900 got %d (%v)\n", len(old), err)
+	}
+
+	if old[0].UmmAlQura != (hijri.UmmAlQuraDate{}) || old[0].Hijri.Year != 1318 {
+		t.Errorf("want only arithmetic date got %v and %v\n", old[0].UmmAlQura, old[0].Hijri)
+	}
+}
@@ -0,0 +1,39 @@
+package hijri
+
+import (
+	"errors"
+	"time"
+)
+
+// fcnaCutoff is the latest time of conjunction in UTC, for the month to start on the next day.
+const fcnaCutoff = 12 * time.Hour
+
+// FCNA is the calendar used by Fiqh Council of North America and Islamic Society of North America.
+// A month starts on the next day if the conjunction happened before 12:00 GMT, otherwise it
+// starts on the day after. Since it only needs the conjunction, this calendar has no limit.
+var FCNA Calendar = fcnaCalendar{}
+
+type fcnaCalendar struct{}
+
+// CreateFCNADate converts Gregorian date to Hijri date using FCNA calendar.
+func CreateFCNADate(date time.Time) (Date, error) {
+	return CreateDate(date, FCNA)
+}
+
+func (fcnaCalendar) MonthStart(year, month int64) (time.Time, error) {
+	if year < 1 {
+		return time.Time{}, errors.New("year must be greater than zero")
+	}
+
+	if month < 1 || month > 12 {
+		return time.Time{}, errors.New("month must be between 1 and 12")
+	}
+
+	conjunction := Conjunction(year, month).UTC()
+	day := time.Date(conjunction.Year(), conjunction.Month(), conjunction.Day(), 0, 0, 0, 0, time.UTC)
+	if conjunction.Sub(day) < fcnaCutoff {
+		return day.AddDate(0, 0, 1), nil
+	}
+
+	return day.AddDate(0, 0, 2), nil
+}
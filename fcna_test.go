@@ -0,0 +1,68 @@
+package hijri_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_FCNA_Published(t *testing.T) {
+	// Start of Ramadan and Shawwal announced by Fiqh Council of North America
+	testData, err := generateTestData("test/fcna.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, data := range testData {
+		date, _ := time.Parse("2006-01-02", data.Gregorian)
+		result, err := hijri.CreateFCNADate(date)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		strResult := fmt.Sprintf("%04d-%02d-%02d", result.Year, result.Month, result.Day)
+		if strResult != data.Hijri {
+			t.Errorf("%s: want %s got %s\n", data.Gregorian, data.Hijri, strResult)
+		}
+
+		if gregorian := result.ToGregorian().Format("2006-01-02"); gregorian != data.Gregorian {
+			t.Errorf("%s: want %s got %s\n", data.Hijri, data.Gregorian, gregorian)
+		}
+	}
+}
+
+func Test_FCNA_Cutoff(t *testing.T) {
+	// New moons close to 12:00 UTC, with the month start on the next day only when the conjunction
+	// happened before it
+	testData := []struct {
+		Year        int64
+		Month       int64
+		Conjunction string
+		Start       string
+	}{
+		{1443, 11, "2022-05-30T11:30Z", "2022-05-31"},
+		{1445, 7, "2024-01-11T11:57Z", "2024-01-12"},
+		{1447, 5, "2025-10-21T12:25Z", "2025-10-23"},
+		{1447, 9, "2026-02-17T12:01Z", "2026-02-19"},
+	}
+
+	for _, data := range testData {
+		conjunction, _ := time.Parse("2006-01-02T15:04Z07:00", data.Conjunction)
+		result := hijri.Conjunction(data.Year, data.Month)
+		if diff := result.Sub(conjunction); diff < -time.Minute || diff > time.Minute {
+			t.Errorf("%04d-%02d: want conjunction %s got %s\n", data.Year, data.Month,
+				data.Conjunction, result.UTC().Format(time.RFC3339))
+		}
+
+		start, err := hijri.FCNA.MonthStart(data.Year, data.Month)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if strStart := start.Format("2006-01-02"); strStart != data.Start {
+			t.Errorf("%04d-%02d: want %s got %s\n", data.Year, data.Month, data.Start, strStart)
+		}
+	}
+}
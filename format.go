@@ -0,0 +1,363 @@
+package hijri
+
+import (
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Layouts for Format and Parse. Like time.Format, the layout is written using the reference date,
+// which is Monday, 2 January 2006 AH (in Hijri calendar, of course). The recognized tokens are:
+//
+//	Year:        "2006" "06"
+//	Month:       "January" "Jan" "01" "1"
+//	Day:         "02" "_2" "2"
+//	Weekday:     "Monday" "Mon"
+//	Day of year: "002" "__2"
+//	Era:         "AH" "هـ"
+//
+// The names of months, weekdays and "AH" era follow the locale, which is English by default,
+// while "هـ" is always the Arabic era. Numbers are written using the digit system of the locale.
+// Every other characters in the layout are copied as it is.
+//
+// Formatting doesn't validate the date. The numbers are written as they are, while the weekday,
+// the day of year and the month name that can't be found, e.g. because the date is outside the
+// scope of its calendar, are written as "?". Use Validate to check the date before formatting it.
+const (
+	LayoutISO  = "2006-01-02"
+	LayoutLong = "Monday, 2 January 2006 AH"
+)
+
+// Kinds of token in layout.
+const (
+	tokenNone = iota
+	tokenYear
+	tokenYear2
+	tokenLongMonth
+	tokenShortMonth
+	tokenZeroMonth
+	tokenNumMonth
+	tokenZeroDay
+	tokenUnderDay
+	tokenNumDay
+	tokenLongWeekday
+	tokenShortWeekday
+	tokenZeroYearDay
+	tokenUnderYearDay
+	tokenEra
+	tokenArabicEra
+)
+
+// layoutTokens is the tokens in layout, sorted so the longer one is checked first.
+var layoutTokens = []struct {
+	text string
+	kind int
+}{
+	{"January", tokenLongMonth},
+	{"Jan", tokenShortMonth},
+	{"Monday", tokenLongWeekday},
+	{"Mon", tokenShortWeekday},
+	{"2006", tokenYear},
+	{"002", tokenZeroYearDay},
+	{"__2", tokenUnderYearDay},
+	{"01", tokenZeroMonth},
+	{"02", tokenZeroDay},
+	{"06", tokenYear2},
+	{"_2", tokenUnderDay},
+	{"1", tokenNumMonth},
+	{"2", tokenNumDay},
+	{"AH", tokenEra},
+	{"هـ", tokenArabicEra},
+}
+
+// MonthNames is the English names of Hijri months, with Muharram as the first month.
+var MonthNames = [12]string{
+	"Muharram", "Safar", "Rabi' al-Awwal", "Rabi' al-Thani", "Jumada al-Ula", "Jumada al-Akhirah",
+	"Rajab", "Sha'ban", "Ramadan", "Shawwal", "Dhu al-Qi'dah", "Dhu al-Hijjah",
+}
+
+// ShortMonthNames is the abbreviated English names of Hijri months.
+var ShortMonthNames = [12]string{
+	"Muh.", "Saf.", "Rab. I", "Rab. II", "Jum. I", "Jum. II",
+	"Raj.", "Sha.", "Ram.", "Shaw.", "Dhul-Q.", "Dhul-H.",
+}
+
+// Format returns the date formatted according to layout, e.g. LayoutISO or LayoutLong.
+func (h HijriDate) Format(layout string) string {
+	return h.FormatLocale(layout, nil)
+}
+
+// FormatLocale is like Format but uses the names of months, weekdays and era from the locale, or
+// English if the locale is nil.
+func (h HijriDate) FormatLocale(layout string, locale *Locale) string {
+	var buffer [64]byte
+	return string(h.AppendFormatLocale(buffer[:0], layout, locale))
+}
+
+// AppendFormat is like Format but appends the formatted date to b and returns the extended
+// buffer. It doesn't allocate if b has enough capacity.
+func (h HijriDate) AppendFormat(b []byte, layout string) []byte {
+	return h.AppendFormatLocale(b, layout, nil)
+}
+
+// AppendFormatLocale is like AppendFormat but uses the names from the locale.
+func (h HijriDate) AppendFormatLocale(b []byte, layout string, locale *Locale) []byte {
+	fields := dateFields{year: h.Year, month: h.Month, day: h.Day}
+	needWeekday, needYearDay := layoutNeeds(layout)
+	if needWeekday {
+		fields.weekday = h.Weekday()
+	}
+
+	if needYearDay {
+		fields.yearDay = h.YearDay()
+	}
+
+	return appendFormat(b, layout, fields, locale)
+}
+
+// Format returns the date formatted according to layout, e.g. LayoutISO or LayoutLong.
+func (uq UmmAlQuraDate) Format(layout string) string {
+	return uq.FormatLocale(layout, nil)
+}
+
+// FormatLocale is like Format but uses the names of months, weekdays and era from the locale, or
+// English if the locale is nil.
+func (uq UmmAlQuraDate) FormatLocale(layout string, locale *Locale) string {
+	var buffer [64]byte
+	return string(uq.AppendFormatLocale(buffer[:0], layout, locale))
+}
+
+// AppendFormat is like Format but appends the formatted date to b and returns the extended
+// buffer. It doesn't allocate if b has enough capacity.
+func (uq UmmAlQuraDate) AppendFormat(b []byte, layout string) []byte {
+	return uq.AppendFormatLocale(b, layout, nil)
+}
+
+// AppendFormatLocale is like AppendFormat but uses the names from the locale.
+func (uq UmmAlQuraDate) AppendFormatLocale(b []byte, layout string, locale *Locale) []byte {
+	fields := dateFields{year: uq.Year, month: uq.Month, day: uq.Day}
+	needWeekday, needYearDay := layoutNeeds(layout)
+	if needWeekday {
+		weekday, err := uq.weekday()
+		fields.weekday, fields.invalid = weekday, err != nil
+	}
+
+	if needYearDay && !fields.invalid {
+		yearDay, err := uq.yearDay()
+		fields.yearDay, fields.invalid = yearDay, err != nil
+	}
+
+	return appendFormat(b, layout, fields, locale)
+}
+
+// Format returns the date formatted according to layout, e.g. LayoutISO or LayoutLong.
+func (d Date) Format(layout string) string {
+	return d.FormatLocale(layout, nil)
+}
+
+// FormatLocale is like Format but uses the names of months, weekdays and era from the locale, or
+// English if the locale is nil.
+func (d Date) FormatLocale(layout string, locale *Locale) string {
+	var buffer [64]byte
+	return string(d.AppendFormatLocale(buffer[:0], layout, locale))
+}
+
+// AppendFormat is like Format but appends the formatted date to b and returns the extended
+// buffer. It doesn't allocate if b has enough capacity, although the calendar might allocate
+// when the layout needs the weekday or the day of year.
+func (d Date) AppendFormat(b []byte, layout string) []byte {
+	return d.AppendFormatLocale(b, layout, nil)
+}
+
+// AppendFormatLocale is like AppendFormat but uses the names from the locale.
+func (d Date) AppendFormatLocale(b []byte, layout string, locale *Locale) []byte {
+	fields := dateFields{year: d.Year, month: d.Month, day: d.Day}
+	needWeekday, needYearDay := layoutNeeds(layout)
+	if needWeekday {
+		weekday, err := d.weekday()
+		fields.weekday, fields.invalid = weekday, err != nil
+	}
+
+	if needYearDay && !fields.invalid {
+		yearDay, err := d.yearDay()
+		fields.yearDay, fields.invalid = yearDay, err != nil
+	}
+
+	return appendFormat(b, layout, fields, locale)
+}
+
+// dateFields is the values of a Hijri date used in formatting. Weekday and day of year are only
+// calculated when the layout needs them, and invalid marks that they can't be calculated because
+// the date is outside the scope of its calendar.
+type dateFields struct {
+	year    int64
+	month   int64
+	day     int64
+	yearDay int64
+	weekday time.Weekday
+	invalid bool
+}
+
+func appendFormat(b []byte, layout string, date dateFields, locale *Locale) []byte {
+	locale = localeOrDefault(locale)
+	for layout != "" {
+		prefix, kind, suffix := nextToken(layout)
+		b = append(b, prefix...)
+		if kind == tokenNone {
+			break
+		}
+		layout = suffix
+
+		switch kind {
+		case tokenYear:
+			b = appendInt(b, date.year, 4, '0', locale.Digits)
+		case tokenYear2:
+			b = appendInt(b, date.year%100, 2, '0', locale.Digits)
+		case tokenLongMonth:
+			b = append(b, monthName(locale.Months, date.month)...)
+		case tokenShortMonth:
+			b = append(b, monthName(locale.ShortMonths, date.month)...)
+		case tokenZeroMonth:
+			b = appendInt(b, date.month, 2, '0', locale.Digits)
+		case tokenNumMonth:
+			b = appendInt(b, date.month, 0, 0, locale.Digits)
+		case tokenZeroDay:
+			b = appendInt(b, date.day, 2, '0', locale.Digits)
+		case tokenUnderDay:
+			b = appendInt(b, date.day, 2, ' ', locale.Digits)
+		case tokenNumDay:
+			b = appendInt(b, date.day, 0, 0, locale.Digits)
+		case tokenLongWeekday:
+			b = append(b, weekdayName(locale.Weekdays, date)...)
+		case tokenShortWeekday:
+			b = append(b, weekdayName(locale.ShortWeekdays, date)...)
+		case tokenZeroYearDay:
+			b = appendYearDay(b, date, '0', locale.Digits)
+		case tokenUnderYearDay:
+			b = appendYearDay(b, date, ' ', locale.Digits)
+		case tokenEra:
+			b = append(b, locale.Era...)
+		case tokenArabicEra:
+			b = append(b, "هـ"...)
+		}
+
+		if locale.RightToLeft && isNumberToken(kind) && startsWithSeparator(layout) {
+			b = append(b, rightToLeftMark...)
+		}
+	}
+
+	return b
+}
+
+// rightToLeftMark is the invisible character which keeps the order of numbers in right-to-left
+// text, as in the date patterns of Arabic locales in CLDR.
+const rightToLeftMark = "\u200f"
+
+// isNumberToken checks whether the token is formatted as a number.
+func isNumberToken(kind int) bool {
+	switch kind {
+	case tokenYear, tokenYear2, tokenZeroMonth, tokenNumMonth, tokenZeroDay, tokenUnderDay,
+		tokenNumDay, tokenZeroYearDay, tokenUnderYearDay:
+		return true
+	default:
+		return false
+	}
+}
+
+// startsWithSeparator checks whether the text starts with punctuation or symbol, e.g. "/" or "-".
+func startsWithSeparator(text string) bool {
+	r, _ := utf8.DecodeRuneInString(text)
+	return r != utf8.RuneError && (unicode.IsPunct(r) || unicode.IsSymbol(r))
+}
+
+// layoutNeeds checks whether the layout contains weekday and day of year.
+func layoutNeeds(layout string) (weekday, yearDay bool) {
+	for layout != "" {
+		var kind int
+		_, kind, layout = nextToken(layout)
+		switch kind {
+		case tokenLongWeekday, tokenShortWeekday:
+			weekday = true
+		case tokenZeroYearDay, tokenUnderYearDay:
+			yearDay = true
+		}
+	}
+	return
+}
+
+// nextToken finds the first token in layout, and returns the text before it, the kind of the token
+// and the text after it. If there are no token, it returns the whole layout as prefix.
+func nextToken(layout string) (prefix string, kind int, suffix string) {
+	for i := 0; i < len(layout); i++ {
+		for _, token := range layoutTokens {
+			if len(layout)-i >= len(token.text) && layout[i:i+len(token.text)] == token.text {
+				return layout[:i], token.kind, layout[i+len(token.text):]
+			}
+		}
+	}
+
+	return layout, tokenNone, ""
+}
+
+// appendInt appends the decimal number using the digit system, padded to the width using the pad
+// character. Zero padding uses the zero of the digit system.
+func appendInt(b []byte, value int64, width int, pad byte, digitSystem DigitSystem) []byte {
+	if value < 0 {
+		b = append(b, '-')
+		value = -value
+	}
+
+	var digits [20]byte
+	i := len(digits)
+	for {
+		i--
+		digits[i] = byte('0' + value%10)
+		value /= 10
+		if value == 0 {
+			break
+		}
+	}
+
+	for n := len(digits) - i; n < width; n++ {
+		if pad == '0' {
+			b = digitSystem.appendDigit(b, 0)
+		} else {
+			b = append(b, pad)
+		}
+	}
+
+	for _, digit := range digits[i:] {
+		b = digitSystem.appendDigit(b, int(digit-'0'))
+	}
+
+	return b
+}
+
+// unknownField is written in place of the weekday, day of year or month name which can't be
+// formatted, e.g. when the date is outside the scope of its calendar.
+const unknownField = "?"
+
+// weekdayName returns the name of the weekday, or unknownField if the weekday can't be calculated.
+func weekdayName(names [7]string, date dateFields) string {
+	if date.invalid {
+		return unknownField
+	}
+	return names[date.weekday]
+}
+
+// appendYearDay appends the day of year padded to three digits, or unknownField if it can't be
+// calculated.
+func appendYearDay(b []byte, date dateFields, pad byte, digitSystem DigitSystem) []byte {
+	if date.invalid {
+		return append(b, unknownField...)
+	}
+	return appendInt(b, date.yearDay, 3, pad, digitSystem)
+}
+
+// monthName returns the name of the month, or unknownField if the month is not between 1 and 12.
+func monthName(names [12]string, month int64) string {
+	if month < 1 || month > 12 {
+		return unknownField
+	}
+	return names[month-1]
+}
@@ -0,0 +1,110 @@
+package hijri_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_Format_Layouts(t *testing.T) {
+	// 27 Rabi' al-Thani 1447 H is Sunday, 19 October 2025
+	hijriDate := hijri.HijriDate{Year: 1447, Month: 4, Day: 27}
+	ummAlQuraDate := hijri.UmmAlQuraDate{Year: 1447, Month: 4, Day: 27}
+	date := hijri.Date{Year: 1447, Month: 4, Day: 27, Calendar: hijri.UmmAlQura}
+
+	tests := []struct {
+		Layout   string
+		Expected string
+	}{
+		{hijri.LayoutISO, "1447-04-27"},
+		{hijri.LayoutLong, "Sunday, 27 Rabi' al-Thani 1447 AH"},
+		{"02/01/2006", "27/04/1447"},
+		{"2 Jan 06", "27 Rab. II 47"},
+		{"Mon, _2-1-2006 هـ", "Sun, 27-4-1447 هـ"},
+		{"day 002 of 2006", "day 116 of 1447"},
+		{"[__2]", "[116]"},
+		{"no token", "no token"},
+	}
+
+	for _, test := range tests {
+		if result := ummAlQuraDate.Format(test.Layout); result != test.Expected {
+			t.Errorf("%q: want %q got %q\n", test.Layout, test.Expected, result)
+		}
+
+		if result := date.Format(test.Layout); result != test.Expected {
+			t.Errorf("%q: want %q got %q\n", test.Layout, test.Expected, result)
+		}
+	}
+
+	// Arithmetic date is a day apart, but the layout works the same
+	if result := hijriDate.Format(hijri.LayoutLong); result != "Monday, 27 Rabi' al-Thani 1447 AH" {
+		t.Errorf("want Monday, 27 Rabi' al-Thani 1447 AH got %s\n", result)
+	}
+
+	// Padding for small numbers
+	small := hijri.HijriDate{Year: 5, Month: 1, Day: 3}
+	if result := small.Format("2006-01-02 _2 002 __2 06"); result != "0005-01-03  3 003   3 05" {
+		t.Errorf("want 0005-01-03  3 003   3 05 got %q\n", result)
+	}
+}
+
+func Test_Format_MonthNames(t *testing.T) {
+	for month := int64(1); month <= 12; month++ {
+		date := hijri.HijriDate{Year: 1447, Month: month, Day: 1}
+		if result := date.Format("January"); result != hijri.MonthNames[month-1] {
+			t.Errorf("%d: want %s got %s\n", month, hijri.MonthNames[month-1], result)
+		}
+	}
+
+	if hijri.MonthNames[8] != "Ramadan" || hijri.MonthNames[11] != "Dhu al-Hijjah" {
+		t.Errorf("invalid month names\n")
+	}
+}
+
+func Test_Format_InvalidDate(t *testing.T) {
+	// Formatting doesn't validate the date, so check it first
+	invalid := hijri.HijriDate{Year: 1447, Month: 13, Day: 1}
+	if err := invalid.Validate(); err == nil {
+		t.Errorf("month 13: want error got nil\n")
+	}
+
+	if result := invalid.Format("2 January 2006"); result != "1 ? 1447" {
+		t.Errorf("month 13: want %q got %q\n", "1 ? 1447", result)
+	}
+
+	outside := hijri.UmmAlQuraDate{Year: 1700, Month: 1, Day: 1}
+	if err := outside.Validate(); err == nil {
+		t.Errorf("1700-01-01: want error got nil\n")
+	}
+
+	if result := outside.Format(hijri.LayoutLong); result != "?, 1 Muharram 1700 AH" {
+		t.Errorf("1700-01-01: want %q got %q\n", "?, 1 Muharram 1700 AH", result)
+	}
+
+	// 30 Dhu al-Hijjah only exists in leap year
+	leap := hijri.HijriDate{Year: 1445, Month: 12, Day: 30}
+	if err := leap.Validate(); err != nil {
+		t.Errorf("1445-12-30: %v\n", err)
+	}
+
+	leap.Year = 1446
+	if err := leap.Validate(); err == nil {
+		t.Errorf("1446-12-30: want error got nil\n")
+	}
+}
+
+func Test_Format_Allocation(t *testing.T) {
+	hijriDate := hijri.HijriDate{Year: 1447, Month: 4, Day: 27}
+	ummAlQuraDate, _ := hijri.CreateUmmAlQuraDate(time.Date(2025, 10, 19, 0, 0, 0, 0, time.UTC))
+	buffer := make([]byte, 0, 64)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buffer = hijriDate.AppendFormat(buffer[:0], hijri.LayoutLong)
+		buffer = ummAlQuraDate.AppendFormat(buffer[:0], "Mon 002 2006-01-02 AH")
+	})
+
+	if allocs != 0 {
+		t.Errorf("want no allocation got %f\n", allocs)
+	}
+}
@@ -0,0 +1,223 @@
+package hijri
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hablullah/go-hijri/astro"
+)
+
+// Limit of the area that checked by UnifiedCalendar, in degrees.
+const (
+	globalMaxLatitude = 60
+	globalGridStep    = 5
+)
+
+// americasMainland is the rough longitude range of the Americas mainland for each latitude in the
+// grid of UnifiedCalendar, which used for the exception in the unified calendar. Latitude without
+// mainland, e.g. 55° S and 60° S, is not listed.
+var americasMainland = map[int][2]float64{
+	60:  {-165, -64},
+	55:  {-162, -60},
+	50:  {-128, -60},
+	45:  {-124, -61},
+	40:  {-124, -74},
+	35:  {-121, -76},
+	30:  {-116, -81},
+	25:  {-112, -80},
+	20:  {-105, -87},
+	15:  {-92, -83},
+	10:  {-85, -61},
+	5:   {-77, -51},
+	0:   {-80, -50},
+	-5:  {-81, -35},
+	-10: {-78, -36},
+	-15: {-76, -39},
+	-20: {-70, -40},
+	-25: {-70, -48},
+	-30: {-71, -50},
+	-35: {-72, -57},
+	-40: {-73, -62},
+	-45: {-74, -65},
+	-50: {-75, -69},
+}
+
+// inAmericas checks whether the grid location is on the Americas mainland.
+func inAmericas(latitude int, longitude float64) bool {
+	bounds, ok := americasMainland[latitude]
+	return ok && longitude >= bounds[0] && longitude <= bounds[1]
+}
+
+// NewZealand is the location of Wellington, which dawn is used for the exception in the unified
+// calendar.
+var NewZealand = astro.Observer{Latitude: -41.2865, Longitude: 174.7762}
+
+// KHGT is the unified global Hijri calendar (Kalender Hijriah Global Tunggal) which adopted by
+// Muhammadiyah since 1446 H, using the criterion from Istanbul congress in 2016. Like the published
+// calendar, the crescent is checked at the sunset of the Sun's center without refraction.
+var KHGT = newKHGT()
+
+func newKHGT() *UnifiedCalendar {
+	uc := NewUnifiedCalendar(5, 8)
+	uc.SetObserverModel(astro.Observer{Refraction: astro.NoRefraction, Limb: astro.CenterLimb})
+	return uc
+}
+
+// UnifiedCalendar is a single global Hijri calendar as adopted by the International Hijri Calendar
+// Union Congress in Istanbul 2016. A month starts on the next day if anywhere on Earth, at sunset
+// before 00:00 UTC, the crescent altitude and its geocentric elongation have reached the minimum
+// values. If the minimum values are only reached after 00:00 UTC, the month still starts if it
+// happened on the Americas mainland and the conjunction happened before dawn in New Zealand.
+//
+// The calendar caches each month start, since finding it requires checking the whole world.
+type UnifiedCalendar struct {
+	minAltitude   float64
+	minElongation float64
+	model         astro.Observer
+
+	mutex  sync.Mutex
+	starts map[int64]int64
+}
+
+// NewUnifiedCalendar creates a unified global calendar with the specified minimum altitude and
+// elongation of the crescent in degrees, e.g. 5° and 8° for Istanbul 2016 criterion.
+func NewUnifiedCalendar(minAltitude, minElongation float64) *UnifiedCalendar {
+	return &UnifiedCalendar{
+		minAltitude:   minAltitude,
+		minElongation: minElongation,
+		starts:        map[int64]int64{},
+	}
+}
+
+// CreateKHGTDate converts Gregorian date to Hijri date using KHGT calendar.
+func CreateKHGTDate(date time.Time) (Date, error) {
+	return CreateDate(date, KHGT)
+}
+
+// SetObserverModel sets the conventions of limb, refraction and horizon that used to find sunset
+// in every location checked by the calendar. Only those conventions are taken from the model, and
+// the cached month starts will be cleared.
+func (uc *UnifiedCalendar) SetObserverModel(model astro.Observer) {
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+
+	uc.model = astro.Observer{
+		Refraction: model.Refraction,
+		Limb:       model.Limb,
+		Horizon:    model.Horizon,
+	}
+	uc.starts = map[int64]int64{}
+}
+
+// MonthStart returns the Gregorian date of the first day of the specified Hijri month.
+func (uc *UnifiedCalendar) MonthStart(year, month int64) (time.Time, error) {
+	if year < 1 {
+		return time.Time{}, errors.New("year must be greater than zero")
+	}
+
+	if month < 1 || month > 12 {
+		return time.Time{}, errors.New("month must be between 1 and 12")
+	}
+
+	iln := lunationNumber(year, month)
+	uc.mutex.Lock()
+	start, cached := uc.starts[iln]
+	uc.mutex.Unlock()
+
+	if cached {
+		return jdnToTime(start), nil
+	}
+
+	decision, err := uc.Explain(year, month)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	monthStart := decision.Start
+	start, err = timeToJDN(monthStart)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	uc.mutex.Lock()
+	uc.starts[iln] = start
+	uc.mutex.Unlock()
+
+	return monthStart, nil
+}
+
+// worldCheck is the result of checking the crescent over the whole world on an evening. The
+// observer is the first location that passed, or the one with the highest Moon if none passed. If
+// the sunset doesn't happen after the conjunction anywhere, found is false and there is no observer.
+type worldCheck struct {
+	observer  astro.Observer
+	found     bool
+	passed    bool
+	exception bool
+
+	// nzDawn is the dawn in New Zealand on the next day, which is zero if it doesn't happen.
+	nzDawn time.Time
+}
+
+// checkWorld checks whether the crescent on the evening of the specified UTC date reached the
+// minimum values somewhere on Earth, so the next day starts a new month.
+func (uc *UnifiedCalendar) checkWorld(day time.Time, conjunction time.Time) worldCheck {
+	midnight := day.AddDate(0, 0, 1)
+
+	// Find dawn in New Zealand on the next day, for the exception
+	nzDay := time.Date(midnight.Year(), midnight.Month(), midnight.Day(), 0, 0, 0, 0, observerLocation(NewZealand))
+	nzDawn := astro.Sun.TimeAtAltitude(nzDay, NewZealand, -18, true)
+	exception := !nzDawn.IsZero() && conjunction.Before(nzDawn)
+
+	// Check from the west, where the crescent is most likely to be visible
+	uc.mutex.Lock()
+	obs := uc.model
+	uc.mutex.Unlock()
+
+	best := worldCheck{nzDawn: nzDawn}
+	bestAltitude := -90.0
+	for longitude := -180.0; longitude < 180; longitude += globalGridStep {
+		for latitude := -globalMaxLatitude; latitude <= globalMaxLatitude; latitude += globalGridStep {
+			obs.Latitude, obs.Longitude = float64(latitude), longitude
+			sunset, altitude, passed := uc.checkEvening(day, obs, conjunction)
+			if altitude > bestAltitude {
+				best.observer, best.found, bestAltitude = obs, true, altitude
+			}
+
+			if !passed {
+				continue
+			}
+
+			if sunset.Before(midnight) {
+				return worldCheck{observer: obs, found: true, passed: true, nzDawn: nzDawn}
+			}
+
+			if exception && inAmericas(latitude, longitude) {
+				return worldCheck{observer: obs, found: true, passed: true, exception: true, nzDawn: nzDawn}
+			}
+		}
+	}
+
+	return best
+}
+
+// checkEvening checks whether at the sunset of local date at the location, the crescent already
+// reached the minimum altitude and elongation. It also returns the Moon altitude at sunset, or -90°
+// if the sunset doesn't happen after the conjunction.
+func (uc *UnifiedCalendar) checkEvening(day time.Time, obs astro.Observer, conjunction time.Time) (time.Time, float64, bool) {
+	localDay := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, observerLocation(obs))
+	sunset := astro.Sun.Set(localDay, obs)
+	if sunset.IsZero() || !sunset.After(conjunction) {
+		return sunset, -90, false
+	}
+
+	moon := astro.Moon.Position(sunset, obs)
+	if moon.Altitude < uc.minAltitude {
+		return sunset, moon.Altitude, false
+	}
+
+	sun := astro.Sun.Position(sunset, obs)
+	elongation := astro.AngularSeparation(sun.Longitude, sun.Latitude, moon.Longitude, moon.Latitude)
+	return sunset, moon.Altitude, elongation >= uc.minElongation
+}
@@ -0,0 +1,115 @@
+package hijri_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+	"github.com/hablullah/go-hijri/astro"
+)
+
+func Test_Global_KHGT(t *testing.T) {
+	// Dates published in KHGT calendar by Muhammadiyah, and the start of Ramadan, Eid al-Fitr and
+	// Eid al-Adha announced by Turkish Diyanet since 1442 H, which uses the same criterion
+	testData, err := generateTestData("test/khgt.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, data := range testData {
+		date, _ := time.Parse("2006-01-02", data.Gregorian)
+		result, err := hijri.CreateKHGTDate(date)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		strResult := fmt.Sprintf("%04d-%02d-%02d", result.Year, result.Month, result.Day)
+		if strResult != data.Hijri {
+			t.Errorf("%s: want %s got %s\n", data.Gregorian, data.Hijri, strResult)
+		}
+
+		if gregorian := result.ToGregorian().Format("2006-01-02"); gregorian != data.Gregorian {
+			t.Errorf("%s: want %s got %s\n", data.Hijri, data.Gregorian, gregorian)
+		}
+	}
+}
+
+func Test_Global_MonthLength(t *testing.T) {
+	months, err := hijri.Months(hijri.KHGT, 1447)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, month := range months {
+		if month.Days != 29 && month.Days != 30 {
+			t.Errorf("1447-%02d: has %d days\n", month.Month, month.Days)
+		}
+
+		// Month always starts after the conjunction, at most two days after
+		age := month.Start.Sub(month.Conjunction())
+		if age < 0 || age > 3*24*time.Hour {
+			t.Errorf("1447-%02d: start %s, conjunction %s\n", month.Month,
+				month.Start.Format("2006-01-02"), month.Conjunction().Format(time.RFC3339))
+		}
+	}
+}
+
+func Test_Global_Criterion(t *testing.T) {
+	// Without minimum values, month starts on the day after conjunction. For Shawwal 1447 H the
+	// conjunction is on 19 March 2026 at 01:23 UTC.
+	cal := hijri.NewUnifiedCalendar(0, 0)
+	start, err := cal.MonthStart(1447, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := start.Format("2006-01-02"); result != "2026-03-20" {
+		t.Errorf("want 2026-03-20 got %s\n", result)
+	}
+
+	// With very strict criterion, it needs one more day
+	cal = hijri.NewUnifiedCalendar(5, 20)
+	if start, err = cal.MonthStart(1447, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	if result := start.Format("2006-01-02"); result != "2026-03-21" {
+		t.Errorf("want 2026-03-21 got %s\n", result)
+	}
+}
+
+func Test_Global_ObserverModel(t *testing.T) {
+	// With the default sunset, the crescent for Ramadan 1447 H doesn't reach the minimum values on
+	// the Americas mainland, so the month starts on 19 February 2026
+	start, err := hijri.NewUnifiedCalendar(5, 8).MonthStart(1447, 9)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := start.Format("2006-01-02"); result != "2026-02-19" {
+		t.Errorf("want 2026-02-19 got %s\n", result)
+	}
+
+	// Using the center of the Sun without refraction, sunset in Alaska happens a few minutes earlier
+	// while the Moon is higher, so the month starts on 18 February 2026 as published
+	calendar := hijri.NewUnifiedCalendar(5, 8)
+	calendar.SetObserverModel(astro.Observer{Refraction: astro.NoRefraction, Limb: astro.CenterLimb})
+
+	if start, err = calendar.MonthStart(1447, 9); err != nil {
+		t.Fatal(err)
+	}
+
+	if result := start.Format("2006-01-02"); result != "2026-02-18" {
+		t.Errorf("want 2026-02-18 got %s\n", result)
+	}
+
+	// KHGT uses the same model by default
+	if start, err = hijri.KHGT.MonthStart(1447, 9); err != nil {
+		t.Fatal(err)
+	}
+
+	if result := start.Format("2006-01-02"); result != "2026-02-18" {
+		t.Errorf("KHGT: want 2026-02-18 got %s\n", result)
+	}
+}
@@ -112,6 +112,55 @@ func CreateHijriDate(date time.Time, leapPattern LeapYearsPattern) (HijriDate, e
 
 // ToGregorian convert Hijri date to Gregorian date using Golang standard time.
 func (h HijriDate) ToGregorian() time.Time {
+	return jdnToTime(h.julianDayNumber())
+}
+
+// Validate checks whether the date exists in the arithmetic calendar, i.e. the year is greater than
+// zero, the month is between 1 and 12, and the day is within the length of the month.
+func (h HijriDate) Validate() error {
+	if h.Year < 1 {
+		return errors.New("year must be greater than zero")
+	}
+
+	if h.Month < 1 || h.Month > 12 {
+		return errors.New("month must be between 1 and 12")
+	}
+
+	daysInMonth := int64(29 + h.Month%2)
+	if h.Month == 12 && isLeapYear(h.Year, h.Pattern) {
+		daysInMonth = 30
+	}
+
+	if h.Day < 1 || h.Day > daysInMonth {
+		return errors.New("day is outside the month")
+	}
+
+	return nil
+}
+
+// Weekday returns the day of the week of this Hijri date.
+func (h HijriDate) Weekday() time.Weekday {
+	return jdnWeekday(h.julianDayNumber())
+}
+
+// YearDay returns the day of the year of this Hijri date, in the range [1, 355].
+func (h HijriDate) YearDay() int64 {
+	passedDays := h.Day
+	for month := int64(1); month < h.Month; month++ {
+		passedDays += 29 + month%2
+	}
+
+	return passedDays
+}
+
+// Week returns the week number of this Hijri date, in the range [1, 52]. The first week of
+// the year is the week that contains 1 Muharram, and every week begins on the specified weekday
+// (usually Saturday, Sunday or Monday).
+func (h HijriDate) Week(firstDay time.Weekday) int64 {
+	return yearWeek(h.YearDay(), h.Weekday(), firstDay)
+}
+
+func (h HijriDate) julianDayNumber() int64 {
 	// Calculate the passed days from the passed hijri years
 	passedYear := h.Year - 1
 	nCycles := passedYear / 30
@@ -134,9 +183,23 @@ func (h HijriDate) ToGregorian() time.Time {
 	// Increase passed days using current hijri day
 	passedDays += h.Day
 
-	// Calculate Julian Days since Hijri epoch
-	jd := 1948438.5 + float64(passedDays)
-	return juliandays.ToTime(jd)
+	// Calculate Julian Day Number since Hijri epoch (JD 1948438.5)
+	return 1948439 + passedDays
+}
+
+// MonthStart returns the Gregorian date of the first day of the specified month in arithmetic
+// Hijri calendar that uses this leap years pattern.
+func (pattern LeapYearsPattern) MonthStart(year, month int64) (time.Time, error) {
+	if year < 1 {
+		return time.Time{}, errors.New("year is before hijri calendar started")
+	}
+
+	if month < 1 || month > 12 {
+		return time.Time{}, errors.New("month must be between 1 and 12")
+	}
+
+	hijriDate := HijriDate{Day: 1, Month: month, Year: year, Pattern: pattern}
+	return hijriDate.ToGregorian(), nil
 }
 
 func isLeapYear(year int64, pattern LeapYearsPattern) bool {
@@ -43,11 +43,10 @@ func Test_Hijri_ToGregorian(t *testing.T) {
 	}
 
 	for _, data := range hijriTestData {
-		var hijriDate hijri.HijriDate
-		fmt.Sscanf(data.Hijri, "%d-%d-%d",
-			&hijriDate.Year,
-			&hijriDate.Month,
-			&hijriDate.Day)
+		hijriDate, err := hijri.ParseHijriDate(hijri.LayoutISO, data.Hijri, hijri.Default)
+		if err != nil {
+			t.Fatal(err)
+		}
 
 		result := hijriDate.ToGregorian().Format("2006-01-02")
 		if result != data.Gregorian {
@@ -83,3 +82,45 @@ func Test_Hijri_Bidirectional(t *testing.T) {
 		date = date.AddDate(0, 0, 1)
 	}
 }
+
+func Test_Hijri_CalendarFields(t *testing.T) {
+	for _, data := range hijriTestData {
+		gregorianDate, _ := time.Parse("2006-01-02", data.Gregorian)
+		hijriDate, _ := hijri.CreateHijriDate(gregorianDate, hijri.Default)
+
+		if weekday := hijriDate.Weekday(); weekday != gregorianDate.Weekday() {
+			t.Errorf("%s: want %s got %s\n", data.Hijri, gregorianDate.Weekday(), weekday)
+		}
+
+		newYear := hijri.HijriDate{Day: 1, Month: 1, Year: hijriDate.Year}
+		yearDay := int64(gregorianDate.Sub(newYear.ToGregorian()).Hours()/24) + 1
+		if result := hijriDate.YearDay(); result != yearDay {
+			t.Errorf("%s: want day %d got %d\n", data.Hijri, yearDay, result)
+		}
+	}
+}
+
+func Test_Hijri_Week(t *testing.T) {
+	// 1 Muharram 1445 is Wednesday, 19 July 2023
+	tests := []struct {
+		Date     hijri.HijriDate
+		FirstDay time.Weekday
+		Expected int64
+	}{
+		{hijri.HijriDate{Year: 1445, Month: 1, Day: 1}, time.Saturday, 1},
+		{hijri.HijriDate{Year: 1445, Month: 1, Day: 3}, time.Saturday, 1},
+		{hijri.HijriDate{Year: 1445, Month: 1, Day: 4}, time.Saturday, 2},
+		{hijri.HijriDate{Year: 1445, Month: 1, Day: 4}, time.Sunday, 1},
+		{hijri.HijriDate{Year: 1445, Month: 1, Day: 5}, time.Sunday, 2},
+		{hijri.HijriDate{Year: 1445, Month: 1, Day: 6}, time.Monday, 2},
+		{hijri.HijriDate{Year: 1445, Month: 12, Day: 29}, time.Saturday, 52},
+	}
+
+	for _, test := range tests {
+		if result := test.Date.Week(test.FirstDay); result != test.Expected {
+			t.Errorf("%04d-%02d-%02d (%s): want %d got %d\n",
+				test.Date.Year, test.Date.Month, test.Date.Day,
+				test.FirstDay, test.Expected, result)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package hijri
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// DigitSystem is the set of characters used to write numbers.
+type DigitSystem int
+
+const (
+	// LatinDigits is the European digits 0123456789.
+	LatinDigits DigitSystem = iota
+
+	// ArabicIndicDigits is the digits used in Arabic, ٠١٢٣٤٥٦٧٨٩.
+	ArabicIndicDigits
+
+	// ExtendedArabicIndicDigits is the digits used in Persian and Urdu, ۰۱۲۳۴۵۶۷۸۹.
+	ExtendedArabicIndicDigits
+)
+
+// String returns the name of the digit system.
+func (d DigitSystem) String() string {
+	switch d {
+	case LatinDigits:
+		return "Latin"
+	case ArabicIndicDigits:
+		return "Arabic-Indic"
+	case ExtendedArabicIndicDigits:
+		return "Extended Arabic-Indic"
+	default:
+		return "unknown"
+	}
+}
+
+// appendDigit appends a single digit between 0 and 9 using the digit system.
+func (d DigitSystem) appendDigit(b []byte, digit int) []byte {
+	var r rune
+	switch d {
+	case ArabicIndicDigits:
+		r = '٠' + rune(digit)
+	case ExtendedArabicIndicDigits:
+		r = '۰' + rune(digit)
+	default:
+		return append(b, byte('0'+digit))
+	}
+
+	var buffer [utf8.UTFMax]byte
+	n := utf8.EncodeRune(buffer[:], r)
+	return append(b, buffer[:n]...)
+}
+
+// digitValue returns the value of the digit in any of the digit systems.
+func digitValue(r rune) (int64, bool) {
+	switch {
+	case r >= '0' && r <= '9':
+		return int64(r - '0'), true
+	case r >= '٠' && r <= '٩':
+		return int64(r - '٠'), true
+	case r >= '۰' && r <= '۹':
+		return int64(r - '۰'), true
+	default:
+		return 0, false
+	}
+}
+
+// numberingSystems is the BCP-47 numbering system of each digit system, used in "-u-nu-" extension.
+var numberingSystems = map[string]DigitSystem{
+	"latn":    LatinDigits,
+	"arab":    ArabicIndicDigits,
+	"arabext": ExtendedArabicIndicDigits,
+}
+
+// Locale is the names of Hijri months and weekdays in a language, used for formatting and parsing.
+type Locale struct {
+	// Tag is the BCP-47 language tag of the locale, e.g. "en" or "id".
+	Tag string
+
+	// Months and ShortMonths is the long and short names of the months, with Muharram first. When
+	// the language has no abbreviation, the short names are the same as the long ones.
+	Months      [12]string
+	ShortMonths [12]string
+
+	// Weekdays and ShortWeekdays is the long and short names of the weekdays, with Sunday first
+	// like in time.Weekday. Like the months, the short names may be the same as the long ones.
+	Weekdays      [7]string
+	ShortWeekdays [7]string
+
+	// Era is the abbreviation of Anno Hegirae, used by "AH" in the layout.
+	Era string
+
+	// Digits is the digit system used to format numbers. Parsing accepts every digit system.
+	Digits DigitSystem
+
+	// RightToLeft marks the locale which written from right to left. When formatting, a
+	// right-to-left mark (U+200F) is put after each number followed by a separator, so the
+	// numbers keep their order in bidirectional text, e.g. "27‏/4‏/1447" is shown as 1447/4/27.
+	RightToLeft bool
+}
+
+// english is the default locale, which used by Format, Parse and when the locale is nil.
+var english = Locale{
+	Tag:           "en",
+	Months:        MonthNames,
+	ShortMonths:   ShortMonthNames,
+	Weekdays:      [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	ShortWeekdays: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	Era:           "AH",
+}
+
+// locales is the built-in locales, indexed by the language tag. They are never returned directly,
+// so the caller can't change them. Following CLDR, Arabic, Urdu and Persian have no abbreviated
+// names of months and weekdays, and Swahili has no abbreviated names of weekdays, so their short
+// names are the long ones.
+var locales = map[string]Locale{
+	"en": english,
+	"ar": {
+		Tag: "ar",
+		Months: [12]string{
+			"محرم", "صفر", "ربيع الأول", "ربيع الآخر", "جمادى الأولى", "جمادى الآخرة",
+			"رجب", "شعبان", "رمضان", "شوال", "ذو القعدة", "ذو الحجة",
+		},
+		ShortMonths: [12]string{
+			"محرم", "صفر", "ربيع الأول", "ربيع الآخر", "جمادى الأولى", "جمادى الآخرة",
+			"رجب", "شعبان", "رمضان", "شوال", "ذو القعدة", "ذو الحجة",
+		},
+		Weekdays:      [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		ShortWeekdays: [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		Era:           "هـ",
+		Digits:        ArabicIndicDigits,
+		RightToLeft:   true,
+	},
+	"id": {
+		Tag: "id",
+		Months: [12]string{
+			"Muharram", "Safar", "Rabiul Awal", "Rabiul Akhir", "Jumadil Awal", "Jumadil Akhir",
+			"Rajab", "Sya'ban", "Ramadhan", "Syawal", "Dzulqa'dah", "Dzulhijjah",
+		},
+		ShortMonths: [12]string{
+			"Muh", "Saf", "Rab I", "Rab II", "Jum I", "Jum II",
+			"Raj", "Sya", "Ram", "Syaw", "Dzulq", "Dzulh",
+		},
+		Weekdays:      [7]string{"Minggu", "Senin", "Selasa", "Rabu", "Kamis", "Jumat", "Sabtu"},
+		ShortWeekdays: [7]string{"Min", "Sen", "Sel", "Rab", "Kam", "Jum", "Sab"},
+		Era:           "H",
+	},
+	"ms": {
+		Tag: "ms",
+		Months: [12]string{
+			"Muharam", "Safar", "Rabiulawal", "Rabiulakhir", "Jamadilawal", "Jamadilakhir",
+			"Rejab", "Syaaban", "Ramadan", "Syawal", "Zulkaedah", "Zulhijah",
+		},
+		ShortMonths: [12]string{
+			"Muh.", "Saf.", "Rab. I", "Rab. II", "Jam. I", "Jam. II",
+			"Rej.", "Sya.", "Ram.", "Syaw.", "Zulk.", "Zulh.",
+		},
+		Weekdays:      [7]string{"Ahad", "Isnin", "Selasa", "Rabu", "Khamis", "Jumaat", "Sabtu"},
+		ShortWeekdays: [7]string{"Ahd", "Isn", "Sel", "Rab", "Kha", "Jum", "Sab"},
+		Era:           "H",
+	},
+	"tr": {
+		Tag: "tr",
+		Months: [12]string{
+			"Muharrem", "Safer", "Rebiülevvel", "Rebiülahir", "Cemaziyelevvel", "Cemaziyelahir",
+			"Recep", "Şaban", "Ramazan", "Şevval", "Zilkade", "Zilhicce",
+		},
+		ShortMonths: [12]string{
+			"Muh.", "Saf.", "Reb. I", "Reb. II", "Cem. I", "Cem. II",
+			"Rec.", "Şab.", "Ram.", "Şev.", "Zilk.", "Zilh.",
+		},
+		Weekdays:      [7]string{"Pazar", "Pazartesi", "Salı", "Çarşamba", "Perşembe", "Cuma", "Cumartesi"},
+		ShortWeekdays: [7]string{"Paz", "Pzt", "Sal", "Çar", "Per", "Cum", "Cmt"},
+		Era:           "Hicri",
+	},
+	"ur": {
+		Tag: "ur",
+		Months: [12]string{
+			"محرم", "صفر", "ربیع الاول", "ربیع الثانی", "جمادی الاول", "جمادی الثانی",
+			"رجب", "شعبان", "رمضان", "شوال", "ذوالقعدہ", "ذوالحجہ",
+		},
+		ShortMonths: [12]string{
+			"محرم", "صفر", "ربیع الاول", "ربیع الثانی", "جمادی الاول", "جمادی الثانی",
+			"رجب", "شعبان", "رمضان", "شوال", "ذوالقعدہ", "ذوالحجہ",
+		},
+		Weekdays:      [7]string{"اتوار", "پیر", "منگل", "بدھ", "جمعرات", "جمعہ", "ہفتہ"},
+		ShortWeekdays: [7]string{"اتوار", "پیر", "منگل", "بدھ", "جمعرات", "جمعہ", "ہفتہ"},
+		Era:           "ہجری",
+		Digits:        ExtendedArabicIndicDigits,
+		RightToLeft:   true,
+	},
+	"fa": {
+		Tag: "fa",
+		Months: [12]string{
+			"محرم", "صفر", "ربیع‌الاول", "ربیع‌الثانی", "جمادی‌الاول", "جمادی‌الثانی",
+			"رجب", "شعبان", "رمضان", "شوال", "ذیقعده", "ذیحجه",
+		},
+		ShortMonths: [12]string{
+			"محرم", "صفر", "ربیع‌الاول", "ربیع‌الثانی", "جمادی‌الاول", "جمادی‌الثانی",
+			"رجب", "شعبان", "رمضان", "شوال", "ذیقعده", "ذیحجه",
+		},
+		Weekdays:      [7]string{"یکشنبه", "دوشنبه", "سه‌شنبه", "چهارشنبه", "پنجشنبه", "جمعه", "شنبه"},
+		ShortWeekdays: [7]string{"یکشنبه", "دوشنبه", "سه‌شنبه", "چهارشنبه", "پنجشنبه", "جمعه", "شنبه"},
+		Era:           "ه.ق.",
+		Digits:        ExtendedArabicIndicDigits,
+		RightToLeft:   true,
+	},
+	"fr": {
+		Tag: "fr",
+		Months: [12]string{
+			"mouharram", "safar", "rabia al awal", "rabia ath-thani", "joumada al oula", "joumada ath-thania",
+			"rajab", "chaabane", "ramadan", "chawwal", "dhou al qi`da", "dhou al-hijja",
+		},
+		ShortMonths: [12]string{
+			"mouh.", "saf.", "rab. aw.", "rab. th.", "joum. oul.", "joum. tha.",
+			"raj.", "chaa.", "ram.", "chaw.", "dhou. q.", "dhou. h.",
+		},
+		Weekdays:      [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		ShortWeekdays: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		Era:           "AH",
+	},
+	"bs": {
+		Tag: "bs",
+		Months: [12]string{
+			"Muharrem", "Safer", "Rebiu-l-evvel", "Rebiu-l-ahir", "Džumade-l-ula", "Džumade-l-uhra",
+			"Redžeb", "Ša'ban", "Ramazan", "Ševval", "Zu-l-ka'de", "Zu-l-hidždže",
+		},
+		ShortMonths: [12]string{
+			"Muh.", "Saf.", "Reb. I", "Reb. II", "Dž. I", "Dž. II",
+			"Red.", "Ša.", "Ram.", "Šev.", "Zu-l-k.", "Zu-l-h.",
+		},
+		Weekdays:      [7]string{"nedjelja", "ponedjeljak", "utorak", "srijeda", "četvrtak", "petak", "subota"},
+		ShortWeekdays: [7]string{"ned", "pon", "uto", "sri", "čet", "pet", "sub"},
+		Era:           "h.",
+	},
+	"sw": {
+		Tag: "sw",
+		Months: [12]string{
+			"Muharram", "Safar", "Rabiul Awwal", "Rabiul Akhir", "Jumadal Ula", "Jumadal Akhira",
+			"Rajab", "Shaaban", "Ramadhani", "Shawwal", "Dhul Qaada", "Dhul Hijja",
+		},
+		ShortMonths: [12]string{
+			"Muh", "Saf", "Rab 1", "Rab 2", "Jum 1", "Jum 2",
+			"Raj", "Sha", "Ram", "Shaw", "Dhul Q", "Dhul H",
+		},
+		Weekdays:      [7]string{"Jumapili", "Jumatatu", "Jumanne", "Jumatano", "Alhamisi", "Ijumaa", "Jumamosi"},
+		ShortWeekdays: [7]string{"Jumapili", "Jumatatu", "Jumanne", "Jumatano", "Alhamisi", "Ijumaa", "Jumamosi"},
+		Era:           "AH",
+	},
+}
+
+// LookupLocale returns the built-in locale for the BCP-47 language tag. If there are no locale for
+// the whole tag, its subtags are removed from the end until one found, e.g. "ms-Latn-MY" will use
+// the locale for "ms". The digit system can be chosen using the Unicode extension, e.g.
+// "ar-SA-u-nu-latn" for Arabic with Latin digits. It returns false if the language is not
+// supported. The returned locale is a copy, so it can be modified freely.
+func LookupLocale(tag string) (*Locale, bool) {
+	tag = strings.ToLower(strings.Replace(tag, "_", "-", -1))
+
+	// Separate the extensions, which always come after the language subtags
+	var extension string
+	if idx := strings.Index(tag, "-u-"); idx >= 0 {
+		tag, extension = tag[:idx], tag[idx+3:]
+	}
+
+	for tag != "" {
+		if locale, ok := locales[tag]; ok {
+			return withNumberingSystem(locale, extension), true
+		}
+
+		idx := strings.LastIndex(tag, "-")
+		if idx < 0 {
+			break
+		}
+		tag = tag[:idx]
+	}
+
+	return nil, false
+}
+
+// withNumberingSystem returns a copy of the locale, using the digit system from "nu" keyword in
+// the Unicode extension if there is any.
+func withNumberingSystem(locale Locale, extension string) *Locale {
+	keys := strings.Split(extension, "-")
+	for i := 0; i+1 < len(keys); i++ {
+		if keys[i] != "nu" {
+			continue
+		}
+
+		if digits, ok := numberingSystems[keys[i+1]]; ok {
+			locale.Digits = digits
+		}
+		break
+	}
+
+	return &locale
+}
+
+// localeOrDefault returns the locale, or English if it's nil.
+func localeOrDefault(locale *Locale) *Locale {
+	if locale == nil {
+		return &english
+	}
+	return locale
+}
+
+// Locales returns the tags of all built-in locales, sorted alphabetically.
+func Locales() []string {
+	tags := make([]string, 0, len(locales))
+	for tag := range locales {
+		tags = append(tags, tag)
+	}
+
+	sort.Strings(tags)
+	return tags
+}
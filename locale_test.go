@@ -0,0 +1,221 @@
+package hijri_test
+
+import (
+	"testing"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_Locale_Lookup(t *testing.T) {
+	tests := []struct {
+		Tag      string
+		Expected string
+	}{
+		{"en", "en"},
+		{"id-ID", "id"},
+		{"ms_MY", "ms"},
+		{"ar-Arab-SA", "ar"},
+		{"TR", "tr"},
+		{"fa-IR", "fa"},
+	}
+
+	for _, test := range tests {
+		locale, ok := hijri.LookupLocale(test.Tag)
+		if !ok || locale.Tag != test.Expected {
+			t.Errorf("%s: want locale %s\n", test.Tag, test.Expected)
+		}
+	}
+
+	if _, ok := hijri.LookupLocale("zh-CN"); ok {
+		t.Errorf("zh-CN: want no locale\n")
+	}
+
+	// Every built-in locale has all names
+	tags := hijri.Locales()
+	if len(tags) != 10 {
+		t.Errorf("want 10 locales got %d\n", len(tags))
+	}
+
+	for _, tag := range tags {
+		locale, _ := hijri.LookupLocale(tag)
+		for i := range locale.Months {
+			if locale.Months[i] == "" || locale.ShortMonths[i] == "" {
+				t.Errorf("%s: month %d has no name\n", tag, i+1)
+			}
+		}
+
+		for i := range locale.Weekdays {
+			if locale.Weekdays[i] == "" || locale.ShortWeekdays[i] == "" {
+				t.Errorf("%s: weekday %d has no name\n", tag, i)
+			}
+		}
+
+		if locale.Era == "" {
+			t.Errorf("%s: no era\n", tag)
+		}
+	}
+}
+
+func Test_Locale_ShortNames(t *testing.T) {
+	// Locales without abbreviation use the long names, while the others must have real short names
+	noShortMonths := map[string]bool{"ar": true, "ur": true, "fa": true}
+	noShortWeekdays := map[string]bool{"ar": true, "ur": true, "fa": true, "sw": true}
+
+	for _, tag := range hijri.Locales() {
+		locale, _ := hijri.LookupLocale(tag)
+		if sameMonths := locale.ShortMonths == locale.Months; sameMonths != noShortMonths[tag] {
+			t.Errorf("%s: want short months same as long %t got %t\n", tag, noShortMonths[tag], sameMonths)
+		}
+
+		if sameWeekdays := locale.ShortWeekdays == locale.Weekdays; sameWeekdays != noShortWeekdays[tag] {
+			t.Errorf("%s: want short weekdays same as long %t got %t\n", tag, noShortWeekdays[tag], sameWeekdays)
+		}
+
+		// Short names which exist are shorter than the long ones
+		for i := range locale.Months {
+			if !noShortMonths[tag] && len(locale.ShortMonths[i]) > len(locale.Months[i]) {
+				t.Errorf("%s: short month %q is longer than %q\n", tag, locale.ShortMonths[i], locale.Months[i])
+			}
+		}
+	}
+}
+
+func Test_Locale_Copy(t *testing.T) {
+	// Changing the returned locale doesn't change the built-in one
+	english, _ := hijri.LookupLocale("en")
+	english.Era = "H"
+	hijri.ALALCTransliteration.Locale().Months[8] = "Ramadan"
+
+	date := hijri.UmmAlQuraDate{Year: 1446, Month: 9, Day: 1}
+	if result := date.Format("2 January 2006 AH"); result != "1 Ramadan 1446 AH" {
+		t.Errorf("want 1 Ramadan 1446 AH got %s\n", result)
+	}
+
+	if locale, _ := hijri.LookupLocale("en"); locale.Era != "AH" {
+		t.Errorf("want era AH got %s\n", locale.Era)
+	}
+
+	if result := date.FormatLocale("January", hijri.ALALCTransliteration.Locale()); result != "Ramaḍān" {
+		t.Errorf("want Ramaḍān got %s\n", result)
+	}
+
+	// Nil locale is English
+	if result := date.FormatLocale(hijri.LayoutLong, nil); result != date.Format(hijri.LayoutLong) {
+		t.Errorf("want English got %s\n", result)
+	}
+
+	parsed, err := hijri.ParseUmmAlQuraDateLocale("2 January 2006", "1 Ramadan 1446", nil)
+	if err != nil || parsed != date {
+		t.Errorf("want 1446-09-01 got %s (%v)\n", parsed.Format(hijri.LayoutISO), err)
+	}
+}
+
+func Test_Locale_Format(t *testing.T) {
+	// 1 Ramadan 1446 H is Saturday, 1 March 2025
+	date := hijri.UmmAlQuraDate{Year: 1446, Month: 9, Day: 1}
+	tests := []struct {
+		Tag      string
+		Expected string
+	}{
+		{"en", "Saturday, 1 Ramadan 1446 AH"},
+		{"ar", "السبت, ١ رمضان ١٤٤٦ هـ"},
+		{"id", "Sabtu, 1 Ramadhan 1446 H"},
+		{"ms", "Sabtu, 1 Ramadan 1446 H"},
+		{"tr", "Cumartesi, 1 Ramazan 1446 Hicri"},
+		{"ur", "ہفتہ, ۱ رمضان ۱۴۴۶ ہجری"},
+		{"fa", "شنبه, ۱ رمضان ۱۴۴۶ ه.ق."},
+		{"fr", "samedi, 1 ramadan 1446 AH"},
+		{"bs", "subota, 1 Ramazan 1446 h."},
+		{"sw", "Jumamosi, 1 Ramadhani 1446 AH"},
+	}
+
+	for _, test := range tests {
+		locale, _ := hijri.LookupLocale(test.Tag)
+		value := date.FormatLocale(hijri.LayoutLong, locale)
+		if value != test.Expected {
+			t.Errorf("%s: want %q got %q\n", test.Tag, test.Expected, value)
+		}
+
+		// Parse it back using the same locale
+		result, err := hijri.ParseUmmAlQuraDateLocale(hijri.LayoutLong, value, locale)
+		if err != nil {
+			t.Errorf("%s: %v\n", test.Tag, err)
+		} else if result != date {
+			t.Errorf("%s: want %v got %v\n", test.Tag, date, result)
+		}
+	}
+
+	indonesian, _ := hijri.LookupLocale("id")
+	if result := date.FormatLocale("Mon, 2 Jan 2006", indonesian); result != "Sab, 1 Ram 1446" {
+		t.Errorf("want short names got %q\n", result)
+	}
+
+	result, err := hijri.ParseHijriDateLocale("2 January 2006", "12 Rabiul Awal 1447", hijri.Default, indonesian)
+	if err != nil || result.Month != 3 {
+		t.Errorf("want Rabiul Awal got %v (%v)\n", result, err)
+	}
+}
+
+func Test_Locale_Digits(t *testing.T) {
+	// 27 Rabi' al-Akhir 1447 H is Sunday, 19 October 2025
+	date := hijri.UmmAlQuraDate{Year: 1447, Month: 4, Day: 27}
+	tests := []struct {
+		Tag      string
+		Layout   string
+		Expected string
+	}{
+		{"ar", "2006/01/02", "١٤٤٧\u200f/٠٤\u200f/٢٧"},
+		{"ar", "2/1/2006 هـ", "٢٧\u200f/٤\u200f/١٤٤٧ هـ"},
+		{"ar-SA-u-nu-latn", "2006-01-02", "1447\u200f-04\u200f-27"},
+		{"fa", "2 January 2006", "۲۷ ربیع‌الثانی ۱۴۴۷"},
+		{"ur", "_2 Jan 2006 (__2)", "۲۷ ربیع الثانی ۱۴۴۷ (۱۱۶\u200f)"},
+		{"en-u-nu-arab", "2006-01-02", "١٤٤٧-٠٤-٢٧"},
+		{"id-ID-u-ca-islamic-nu-arabext", "02/01/06", "۲۷/۰۴/۴۷"},
+	}
+
+	for _, test := range tests {
+		locale, ok := hijri.LookupLocale(test.Tag)
+		if !ok {
+			t.Errorf("%s: locale not found\n", test.Tag)
+			continue
+		}
+
+		value := date.FormatLocale(test.Layout, locale)
+		if value != test.Expected {
+			t.Errorf("%s: want %q got %q\n", test.Tag, test.Expected, value)
+		}
+
+		result, err := hijri.ParseUmmAlQuraDateLocale(test.Layout, value, locale)
+		if err != nil {
+			t.Errorf("%s: %v\n", test.Tag, err)
+		} else if result != date {
+			t.Errorf("%s: want %v got %v\n", test.Tag, date, result)
+		}
+	}
+
+	// Parsing accepts any digit system and ignores the bidi marks
+	for _, value := range []string{"1447-04-27", "١٤٤٧-٠٤-٢٧", "۱۴۴۷-۰۴-۲۷", "١٤٤٧\u200f-٠٤\u200f-٢٧", "\u061c1447-04-27\u200e"} {
+		result, err := hijri.ParseUmmAlQuraDate(hijri.LayoutISO, value)
+		if err != nil {
+			t.Errorf("%q: %v\n", value, err)
+		} else if result != date {
+			t.Errorf("%q: want %v got %v\n", value, date, result)
+		}
+	}
+
+	// The built-in locales are not modified by the extension
+	arabic, _ := hijri.LookupLocale("ar")
+	if arabic.Digits != hijri.ArabicIndicDigits || !arabic.RightToLeft {
+		t.Errorf("want Arabic-Indic digits got %s\n", arabic.Digits)
+	}
+
+	// Formatting native digits doesn't allocate either
+	buffer := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		date.AppendFormatLocale(buffer[:0], "2/1/2006 هـ", arabic)
+	})
+
+	if allocs != 0 {
+		t.Errorf("want no allocation got %v\n", allocs)
+	}
+}
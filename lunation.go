@@ -0,0 +1,47 @@
+package hijri
+
+import (
+	"time"
+
+	"github.com/hablullah/go-hijri/astro"
+)
+
+// AstronomicalLunationOffset is the difference between Hijri lunation number and the astronomical
+// lunation number used by package astro, where lunation 0 is the new moon on 6 January 2000 (just
+// before 1 Shawwal 1420 H).
+const AstronomicalLunationOffset = 17038
+
+// Lunation returns the Hijri lunation number of the specified month, i.e. the count of months
+// since the Hijri calendar started, where 1 Muharram 1 H is the start of lunation 1. This is the
+// same number that used to look up Umm al-Qura table.
+func Lunation(year, month int64) int64 {
+	return lunationNumber(year, month)
+}
+
+// LunationMonth converts Hijri lunation number back to its year and month.
+func LunationMonth(lunation int64) (year, month int64) {
+	return lunationMonth(lunation)
+}
+
+// AstronomicalLunation returns the astronomical lunation number of the specified Hijri month,
+// which can be used with package astro.
+func AstronomicalLunation(year, month int64) int64 {
+	return lunationNumber(year, month) - AstronomicalLunationOffset
+}
+
+// Conjunction returns the instant of new moon (conjunction) that marks the beginning of the
+// specified Hijri month, which usually happened a day or two before the month started.
+func Conjunction(year, month int64) time.Time {
+	return astro.NewMoon(AstronomicalLunation(year, month))
+}
+
+// FullMoon returns the instant of full moon in the specified Hijri month.
+func FullMoon(year, month int64) time.Time {
+	return astro.FullMoon(AstronomicalLunation(year, month))
+}
+
+// MonthOfConjunction returns the Hijri year and month which lunation started with the last new
+// moon before or at the specified instant.
+func MonthOfConjunction(t time.Time) (year, month int64) {
+	return lunationMonth(astro.Lunation(t) + AstronomicalLunationOffset)
+}
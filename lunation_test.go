@@ -0,0 +1,45 @@
+package hijri_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_Lunation_Conjunction(t *testing.T) {
+	for year := int64(1357); year <= 1500; year++ {
+		months, err := hijri.Months(hijri.UmmAlQura, year)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, month := range months {
+			// In Umm al-Qura, the month always starts within three days after the conjunction
+			age := month.Start.Sub(month.Conjunction())
+			if age < -24*time.Hour || age > 3*24*time.Hour {
+				t.Errorf("%04d-%02d: start %s, conjunction %s\n", year, month.Month,
+					month.Start.Format("2006-01-02"), month.Conjunction().Format(time.RFC3339))
+			}
+
+			y, m := hijri.MonthOfConjunction(month.Conjunction().Add(time.Minute))
+			if y != year || m != month.Month {
+				t.Errorf("%04d-%02d: got %04d-%02d\n", year, month.Month, y, m)
+			}
+		}
+	}
+}
+
+func Test_Lunation_Number(t *testing.T) {
+	lunation := hijri.Lunation(1447, 9)
+	if year, month := hijri.LunationMonth(lunation); year != 1447 || month != 9 {
+		t.Errorf("want 1447-09 got %04d-%02d\n", year, month)
+	}
+
+	// Ramadan 1447 H started after the new moon on 17 February 2026 at 12:01 UTC
+	expected := time.Date(2026, 2, 17, 12, 1, 0, 0, time.UTC)
+	conjunction := hijri.Conjunction(1447, 9)
+	if diff := conjunction.Sub(expected); diff < -time.Minute || diff > time.Minute {
+		t.Errorf("want %s got %s\n", expected, conjunction)
+	}
+}
@@ -0,0 +1,115 @@
+package hijri
+
+import (
+	"math"
+	"time"
+
+	"github.com/hablullah/go-hijri/astro"
+)
+
+// MoonPhase is the named phase of the Moon.
+type MoonPhase int
+
+// The eight named phases of the Moon, in order within a lunation.
+const (
+	PhaseNewMoon MoonPhase = iota
+	PhaseWaxingCrescent
+	PhaseFirstQuarter
+	PhaseWaxingGibbous
+	PhaseFullMoon
+	PhaseWaningGibbous
+	PhaseLastQuarter
+	PhaseWaningCrescent
+)
+
+var moonPhaseNames = []string{
+	"New Moon", "Waxing Crescent", "First Quarter", "Waxing Gibbous",
+	"Full Moon", "Waning Gibbous", "Last Quarter", "Waning Crescent",
+}
+
+// String returns the English name of the phase.
+func (p MoonPhase) String() string {
+	if p < 0 || int(p) >= len(moonPhaseNames) {
+		return "Unknown"
+	}
+	return moonPhaseNames[p]
+}
+
+// Moon is the state of the Moon at an instant.
+type Moon struct {
+	// Age is the duration since the last conjunction.
+	Age time.Duration
+
+	// Illumination is the illuminated fraction of the Moon's disk, from 0 to 1.
+	Illumination float64
+
+	// PhaseAngle is the angle between the Sun and Earth seen from the Moon, in degrees. It's 180°
+	// at new moon and 0° at full moon.
+	PhaseAngle float64
+
+	// Phase is the named phase, where each of the principal phases (new moon, first quarter, full
+	// moon and last quarter) covers one eighth of the lunation around it.
+	Phase MoonPhase
+}
+
+// MoonAt returns the state of the Moon at the specified instant.
+func MoonAt(t time.Time) Moon {
+	// Find the phase from the difference of ecliptic longitude of the Moon and the Sun
+	sun := astro.Sun.Position(t, astro.Observer{})
+	moon := astro.Moon.Position(t, astro.Observer{})
+	longitude := math.Mod(moon.Longitude-sun.Longitude+360+22.5, 360)
+
+	return Moon{
+		Age:          t.Sub(astro.NewMoon(astro.Lunation(t))),
+		Illumination: astro.Illumination(t),
+		PhaseAngle:   astro.PhaseAngle(t),
+		Phase:        MoonPhase(int(longitude/45) % 8),
+	}
+}
+
+// Moon returns the state of the Moon on this Hijri date, at 12:00 UTC.
+func (d HijriDate) Moon() Moon {
+	return MoonAt(d.ToGregorian().Add(12 * time.Hour))
+}
+
+// Moon returns the state of the Moon on this Umm al-Qura date, at 12:00 UTC. It returns error if
+// the date doesn't exist in the current Umm al-Qura table.
+func (uq UmmAlQuraDate) Moon() (Moon, error) {
+	if err := uq.Validate(); err != nil {
+		return Moon{}, err
+	}
+
+	return MoonAt(uq.ToGregorian().Add(12 * time.Hour)), nil
+}
+
+// Moon returns the state of the Moon on this date, at 12:00 UTC. It returns error if the date
+// doesn't exist in its calendar.
+func (d Date) Moon() (Moon, error) {
+	if err := d.Validate(); err != nil {
+		return Moon{}, err
+	}
+
+	return MoonAt(d.ToGregorian().Add(12 * time.Hour)), nil
+}
+
+// IsWhiteDay returns true if this Hijri date is one of the white days (ayyam al-bidh), i.e. the
+// 13th, 14th and 15th day of the month when the Moon is full or nearly full.
+func (d HijriDate) IsWhiteDay() bool {
+	return isWhiteDay(d.Day)
+}
+
+// IsWhiteDay returns true if this Umm al-Qura date is one of the white days (ayyam al-bidh), i.e.
+// the 13th, 14th and 15th day of the month when the Moon is full or nearly full.
+func (uq UmmAlQuraDate) IsWhiteDay() bool {
+	return isWhiteDay(uq.Day)
+}
+
+// IsWhiteDay returns true if this date is one of the white days (ayyam al-bidh), i.e. the 13th,
+// 14th and 15th day of the month when the Moon is full or nearly full.
+func (d Date) IsWhiteDay() bool {
+	return isWhiteDay(d.Day)
+}
+
+func isWhiteDay(day int64) bool {
+	return day >= 13 && day <= 15
+}
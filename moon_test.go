@@ -0,0 +1,108 @@
+package hijri_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_Moon_Phases(t *testing.T) {
+	// Phases of lunation after the new moon on 10 March 2024 at 09:00 UTC
+	tests := []struct {
+		Time     time.Time
+		Expected hijri.MoonPhase
+	}{
+		{time.Date(2024, 3, 10, 9, 0, 0, 0, time.UTC), hijri.PhaseNewMoon},
+		{time.Date(2024, 3, 13, 0, 0, 0, 0, time.UTC), hijri.PhaseWaxingCrescent},
+		{time.Date(2024, 3, 17, 4, 11, 0, 0, time.UTC), hijri.PhaseFirstQuarter},
+		{time.Date(2024, 3, 21, 0, 0, 0, 0, time.UTC), hijri.PhaseWaxingGibbous},
+		{time.Date(2024, 3, 25, 7, 0, 0, 0, time.UTC), hijri.PhaseFullMoon},
+		{time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC), hijri.PhaseWaningGibbous},
+		{time.Date(2024, 4, 2, 3, 15, 0, 0, time.UTC), hijri.PhaseLastQuarter},
+		{time.Date(2024, 4, 5, 0, 0, 0, 0, time.UTC), hijri.PhaseWaningCrescent},
+	}
+
+	for _, test := range tests {
+		if result := hijri.MoonAt(test.Time).Phase; result != test.Expected {
+			t.Errorf("%s: want %s got %s\n", test.Time.Format(time.RFC3339), test.Expected, result)
+		}
+	}
+}
+
+func Test_Moon_HijriDate(t *testing.T) {
+	// 15 Ramadan 1445 H in Umm al-Qura is 25 March 2024, a few hours after the full moon
+	date := hijri.UmmAlQuraDate{Year: 1445, Month: 9, Day: 15}
+	moon, err := date.Moon()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if moon.Phase != hijri.PhaseFullMoon || moon.Illumination < 0.99 || moon.PhaseAngle > 10 {
+		t.Errorf("want full moon got %s, %.2f illuminated, phase angle %.2f\n",
+			moon.Phase, moon.Illumination, moon.PhaseAngle)
+	}
+
+	if days := moon.Age.Hours() / 24; days < 14.9 || days > 15.2 {
+		t.Errorf("want age around 15 days got %.2f\n", days)
+	}
+
+	if !date.IsWhiteDay() {
+		t.Errorf("15th day must be a white day\n")
+	}
+
+	// On the first day the crescent is thin
+	moon, err = hijri.UmmAlQuraDate{Year: 1445, Month: 9, Day: 1}.Moon()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if moon.Phase != hijri.PhaseNewMoon || moon.Illumination > 0.05 {
+		t.Errorf("want new moon got %s, %.2f illuminated\n", moon.Phase, moon.Illumination)
+	}
+
+	// The arithmetic calendar and computed calendar have the same methods
+	arithmetic := hijri.HijriDate{Year: 1445, Month: 9, Day: 12}
+	if arithmetic.IsWhiteDay() || arithmetic.Moon().Phase != hijri.PhaseWaxingGibbous {
+		t.Errorf("12 Ramadan 1445 H must be waxing gibbous and not a white day\n")
+	}
+
+	date2, err := hijri.CreateDate(time.Date(2024, 3, 24, 0, 0, 0, 0, time.UTC), hijri.UmmAlQura)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	moon, err = date2.Moon()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !date2.IsWhiteDay() || moon.Illumination < 0.95 {
+		t.Errorf("14 Ramadan 1445 H must be a white day\n")
+	}
+}
+
+func Test_Moon_InvalidDate(t *testing.T) {
+	// Dates which don't exist must not be computed from zero time
+	uqDates := []hijri.UmmAlQuraDate{
+		{Year: 1700, Month: 1, Day: 1},
+		{Year: 1445, Month: 13, Day: 1},
+		{Year: 1445, Month: 9, Day: 31},
+		{Year: 1445, Month: 9, Day: 0},
+	}
+
+	for _, date := range uqDates {
+		if err := date.Validate(); err == nil {
+			t.Errorf("%04d-%02d-%02d: want validation error got nil\n", date.Year, date.Month, date.Day)
+		}
+
+		if _, err := date.Moon(); err == nil {
+			t.Errorf("%04d-%02d-%02d: want error got nil\n", date.Year, date.Month, date.Day)
+		}
+	}
+
+	date := hijri.Date{Year: 1445, Month: 9, Day: 31, Calendar: hijri.UmmAlQura}
+	if _, err := date.Moon(); err == nil {
+		t.Errorf("1445-09-31: want error got nil\n")
+	}
+}
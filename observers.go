@@ -0,0 +1,15 @@
+package hijri
+
+import "github.com/hablullah/go-hijri/astro"
+
+// Reference locations which commonly used by calendar authorities.
+var (
+	// Mecca is the location of Ka'bah in Masjid al-Haram.
+	Mecca = astro.Observer{Latitude: 21.4225, Longitude: 39.8262, Elevation: 277}
+
+	// Jakarta is the location of National Monument (Monas), the reference of Indonesian government.
+	Jakarta = astro.Observer{Latitude: -6.175, Longitude: 106.8275, Elevation: 8}
+
+	// Yogyakarta is the reference location of Muhammadiyah, at 7°48' S and 110°21' E.
+	Yogyakarta = astro.Observer{Latitude: -7.8, Longitude: 110.35, Elevation: 114}
+)
@@ -0,0 +1,233 @@
+package hijri
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// OverlayCalendar is a calendar that replaces some month starts of its base calendar, e.g. with
+// the dates that announced by religious authority after the crescent sighting. Months that not
+// listed in the overlay follow the base calendar, unless it makes their neighbour has invalid
+// length. In that case, since a month can't be shorter than 29 days or longer than 30 days, their
+// start is moved by a day to follow the overlay.
+type OverlayCalendar struct {
+	// Info is the provenance of the overlay entries. Its checksum is calculated from the entries
+	// when the calendar is created.
+	Info TableInfo
+
+	base      Calendar
+	entries   []TableEntry
+	once      sync.Once
+	err       error
+	starts    map[int64]int64
+	announced map[int64]bool
+}
+
+// Divergence is a month which start in overlay is different with its base calendar. Announced is
+// the start in overlay, which is either listed in the entries or, when Adjusted is true, moved
+// from the base calendar to keep the length of its announced neighbour valid.
+type Divergence struct {
+	Year       int64
+	Month      int64
+	Calculated time.Time
+	Announced  time.Time
+	Days       int64
+	Adjusted   bool
+}
+
+// NewOverlayCalendar creates a calendar which month starts are taken from the entries, and the
+// rest from the base calendar. Each entry may point to any day of a month.
+func NewOverlayCalendar(base Calendar, entries []TableEntry) (*OverlayCalendar, error) {
+	oc := newLazyOverlayCalendar(base, entries)
+	if err := oc.load(); err != nil {
+		return nil, err
+	}
+
+	return oc, nil
+}
+
+// newLazyOverlayCalendar creates an overlay calendar which entries are only applied when it's used
+// for the first time. If the entries are invalid, the error is returned by MonthStart.
+func newLazyOverlayCalendar(base Calendar, entries []TableEntry) *OverlayCalendar {
+	return &OverlayCalendar{
+		Info:    TableInfo{Checksum: tableChecksum(entries)},
+		base:    base,
+		entries: entries,
+	}
+}
+
+// MonthStart returns the Gregorian date of the first day of the specified Hijri month.
+func (oc *OverlayCalendar) MonthStart(year, month int64) (time.Time, error) {
+	if month < 1 || month > 12 {
+		return time.Time{}, errors.New("month must be between 1 and 12")
+	}
+
+	if err := oc.load(); err != nil {
+		return time.Time{}, err
+	}
+
+	if start, ok := oc.starts[lunationNumber(year, month)]; ok {
+		return jdnToTime(start), nil
+	}
+
+	return oc.base.MonthStart(year, month)
+}
+
+// Divergences returns the list of months in the overlay, sorted by time, which start is different
+// with the one in base calendar. It includes the announced months as well as their neighbours
+// which moved to keep the month lengths valid.
+func (oc *OverlayCalendar) Divergences() []Divergence {
+	if err := oc.load(); err != nil {
+		return nil
+	}
+
+	var divergences []Divergence
+	for _, iln := range oc.sortedLunations() {
+		calculated, err := lunationStart(oc.base, iln)
+		if err != nil || calculated == oc.starts[iln] {
+			continue
+		}
+
+		year, month := lunationMonth(iln)
+		divergences = append(divergences, Divergence{
+			Year:       year,
+			Month:      month,
+			Calculated: jdnToTime(calculated),
+			Announced:  jdnToTime(oc.starts[iln]),
+			Days:       oc.starts[iln] - calculated,
+			Adjusted:   !oc.announced[iln],
+		})
+	}
+
+	return divergences
+}
+
+// load applies the entries on top of the base calendar. It only runs once, and the following calls
+// return the same error.
+func (oc *OverlayCalendar) load() error {
+	oc.once.Do(func() {
+		oc.starts = map[int64]int64{}
+		oc.announced = map[int64]bool{}
+		if oc.err = oc.applyEntries(); oc.err != nil {
+			oc.starts = map[int64]int64{}
+			oc.announced = map[int64]bool{}
+		}
+	})
+
+	return oc.err
+}
+
+func (oc *OverlayCalendar) applyEntries() error {
+	for _, entry := range oc.entries {
+		gregorianDate, err := time.Parse("2006-01-02", entry.Gregorian)
+		if err != nil {
+			return err
+		}
+
+		year, month, day, err := parseHijriEntry(entry.Hijri)
+		if err != nil {
+			return err
+		}
+
+		cjdn, err := timeToJDN(gregorianDate)
+		if err != nil {
+			return err
+		}
+
+		iln := lunationNumber(year, month)
+		start := cjdn - day + 1
+		if existing, ok := oc.starts[iln]; ok && existing != start {
+			return fmt.Errorf("entry %s conflicts with the previous entries", entry.Hijri)
+		}
+
+		oc.starts[iln] = start
+		oc.announced[iln] = true
+	}
+
+	// Move the neighbouring months when their length become invalid
+	for _, iln := range oc.announcedLunations() {
+		if err := oc.adjustNeighbours(iln, -1); err != nil {
+			return err
+		}
+
+		if err := oc.adjustNeighbours(iln, 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// adjustNeighbours walks from an announced month toward the specified direction, and moves the
+// start of each month it passes until all of them have valid length.
+func (oc *OverlayCalendar) adjustNeighbours(iln int64, direction int64) error {
+	for current := iln; ; current += direction {
+		neighbour := current + direction
+		neighbourStart, err := oc.lunationStart(neighbour)
+		if err != nil {
+			return err
+		}
+
+		// Check the length of month between current and its neighbour
+		nDays := (neighbourStart - oc.starts[current]) * direction
+		if nDays == 29 || nDays == 30 {
+			return nil
+		}
+
+		if oc.announced[neighbour] {
+			y, m := lunationMonth(current)
+			if direction < 0 {
+				y, m = lunationMonth(neighbour)
+			}
+			return fmt.Errorf("month %04d-%02d has %d days", y, m, nDays)
+		}
+
+		// Move the neighbour so the month has the closest valid length
+		switch {
+		case nDays < 29:
+			nDays = 29
+		case nDays > 30:
+			nDays = 30
+		}
+
+		oc.starts[neighbour] = oc.starts[current] + nDays*direction
+	}
+}
+
+// sortedLunations returns lunation number of the months in overlay in ascending order, i.e. the
+// announced months and the adjusted neighbours.
+func (oc *OverlayCalendar) sortedLunations() []int64 {
+	lunations := make([]int64, 0, len(oc.starts))
+	for iln := range oc.starts {
+		lunations = append(lunations, iln)
+	}
+
+	sort.Slice(lunations, func(a, b int) bool {
+		return lunations[a] < lunations[b]
+	})
+
+	return lunations
+}
+
+// announcedLunations returns lunation number of the announced months in ascending order.
+func (oc *OverlayCalendar) announcedLunations() []int64 {
+	var lunations []int64
+	for _, iln := range oc.sortedLunations() {
+		if oc.announced[iln] {
+			lunations = append(lunations, iln)
+		}
+	}
+
+	return lunations
+}
+
+func (oc *OverlayCalendar) lunationStart(iln int64) (int64, error) {
+	if start, ok := oc.starts[iln]; ok {
+		return start, nil
+	}
+
+	return lunationStart(oc.base, iln)
+}
@@ -0,0 +1,132 @@
+package hijri_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_OverlayCalendar_Divergences(t *testing.T) {
+	expected := []string{
+		"1427-12: 2006-12-22 -> 2006-12-21 (-1)",
+		"1428-10: 2007-10-13 -> 2007-10-12 (-1)",
+		"1428-12: 2007-12-11 -> 2007-12-10 (-1)",
+		"1429-01: 2008-01-10 -> 2008-01-09 (-1) adjusted",
+		"1429-10: 2008-10-01 -> 2008-09-30 (-1)",
+		"1434-09: 2013-07-09 -> 2013-07-10 (1)",
+		"1436-12: 2015-09-14 -> 2015-09-15 (1)",
+		"1437-12: 2016-09-02 -> 2016-09-03 (1)",
+		"1439-09: 2018-05-16 -> 2018-05-17 (1)",
+	}
+
+	result := formatDivergences(hijri.UmmAlQuraAnnounced.Divergences())
+	if strings.Join(result, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("want:\n%s\ngot:\n%s\n", strings.Join(expected, "\n"), strings.Join(result, "\n"))
+	}
+}
+
+func Test_OverlayCalendar_SaudiAnnouncements(t *testing.T) {
+	// The announcements are only applied when the calendar is used, so make sure they are valid
+	// and every month around them has valid length
+	prevStart, err := hijri.UmmAlQuraAnnounced.MonthStart(1425, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for year := int64(1426); year <= 1447; year++ {
+		for month := int64(1); month <= 12; month++ {
+			start, err := hijri.UmmAlQuraAnnounced.MonthStart(year, month)
+			if err != nil {
+				t.Fatalf("%04d-%02d: %v\n", year, month, err)
+			}
+
+			if nDays := int64(start.Sub(prevStart).Hours() / 24); nDays != 29 && nDays != 30 {
+				t.Errorf("%04d-%02d: previous month has %d days\n", year, month, nDays)
+			}
+
+			prevStart = start
+		}
+	}
+}
+
+func Test_OverlayCalendar_AdjustedNeighbour(t *testing.T) {
+	// Ramadan 1446 H starts a day later than Umm al-Qura, so it would only have 28 days unless
+	// Shawwal is moved as well
+	entries := []hijri.TableEntry{{Gregorian: "2025-03-02", Hijri: "1446-09-01"}}
+	oc, err := hijri.NewOverlayCalendar(hijri.UmmAlQura, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"1446-09: 2025-03-01 -> 2025-03-02 (1)",
+		"1446-10: 2025-03-30 -> 2025-03-31 (1) adjusted",
+	}
+
+	result := formatDivergences(oc.Divergences())
+	if strings.Join(result, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("want:\n%s\ngot:\n%s\n", strings.Join(expected, "\n"), strings.Join(result, "\n"))
+	}
+
+	if _, err := oc.MonthStart(1446, 13); err == nil {
+		t.Errorf("month 13: want error got nil\n")
+	}
+}
+
+func formatDivergences(divergences []hijri.Divergence) []string {
+	var result []string
+	for _, d := range divergences {
+		line := fmt.Sprintf("%04d-%02d: %s -> %s (%d)", d.Year, d.Month,
+			d.Calculated.Format("2006-01-02"), d.Announced.Format("2006-01-02"), d.Days)
+		if d.Adjusted {
+			line += " adjusted"
+		}
+		result = append(result, line)
+	}
+
+	return result
+}
+
+func Test_OverlayCalendar_ConvertDate(t *testing.T) {
+	// Arafah 1436 H is 23 September 2015 as announced, while Umm al-Qura puts it a day earlier
+	tests := map[string]string{
+		"2015-09-23": "1436-12-09",
+		"2015-09-14": "1436-11-30",
+		"2015-09-15": "1436-12-01",
+		"2015-10-14": "1437-01-01",
+		// Dhu al-Hijjah 1428 H started a day earlier, so Muharram 1429 H must follow it
+		"2008-01-08": "1428-12-30",
+		"2008-01-09": "1429-01-01",
+	}
+
+	for gregorian, expected := range tests {
+		date, _ := time.Parse("2006-01-02", gregorian)
+		hijriDate, err := hijri.CreateDate(date, hijri.UmmAlQuraAnnounced)
+		if err != nil {
+			t.Fatalf("%s: %v\n", gregorian, err)
+		}
+
+		result := fmt.Sprintf("%04d-%02d-%02d", hijriDate.Year, hijriDate.Month, hijriDate.Day)
+		if result != expected {
+			t.Errorf("%s: want %s got %s\n", gregorian, expected, result)
+		}
+	}
+}
+
+func Test_OverlayCalendar_Invalid(t *testing.T) {
+	invalidEntries := [][]hijri.TableEntry{
+		// Ramadan 1446 would have 31 days
+		{{Gregorian: "2025-03-01", Hijri: "1446-09-01"}, {Gregorian: "2025-04-01", Hijri: "1446-10-01"}},
+		// Conflicting entries
+		{{Gregorian: "2025-03-01", Hijri: "1446-09-01"}, {Gregorian: "2025-03-03", Hijri: "1446-09-02"}},
+	}
+
+	for _, entries := range invalidEntries {
+		if _, err := hijri.NewOverlayCalendar(hijri.UmmAlQura, entries); err == nil {
+			t.Errorf("want error for %v\n", entries)
+		}
+	}
+}
@@ -0,0 +1,340 @@
+package hijri
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ParseError describes a problem when parsing a Hijri date string.
+type ParseError struct {
+	Layout string
+	Value  string
+
+	// Offset is the position in Value where the problem found, in bytes.
+	Offset int
+
+	// LayoutElem is the layout token that failed to parse, ValueElem is the remaining value that
+	// failed to match it, and Message is the explanation of the problem.
+	LayoutElem string
+	ValueElem  string
+	Message    string
+}
+
+// Error returns the string representation of the error.
+func (e *ParseError) Error() string {
+	prefix := "parsing hijri date " + strconv.Quote(e.Value) + " as " + strconv.Quote(e.Layout)
+	if e.LayoutElem == "" {
+		return prefix + ": " + e.Message + " at position " + strconv.Itoa(e.Offset)
+	}
+
+	return prefix + ": cannot parse " + strconv.Quote(e.ValueElem) + " as " +
+		strconv.Quote(e.LayoutElem) + " at position " + strconv.Itoa(e.Offset) + e.Message
+}
+
+// ParseHijriDate parses a formatted string into arithmetic Hijri date using the leap years
+// pattern. The layout uses the same tokens as Format, and the parsed date must exist in the
+// calendar, e.g. 30 Dhu al-Hijjah is only valid in leap years. Two digits year ("06") is
+// treated as a year in the 15th century AH, i.e. 1400 to 1499. Four digits year ("2006") takes
+// any number of digits, unless another number follows it directly, e.g. "20060102".
+func ParseHijriDate(layout, value string, leapPattern LeapYearsPattern) (HijriDate, error) {
+	return ParseHijriDateLocale(layout, value, leapPattern, nil)
+}
+
+// ParseHijriDateLocale is like ParseHijriDate but uses the names from the locale, or English if
+// the locale is nil.
+func ParseHijriDateLocale(layout, value string, leapPattern LeapYearsPattern, locale *Locale) (HijriDate, error) {
+	year, month, day, err := parseDate(layout, value, leapPattern, locale)
+	if err != nil {
+		return HijriDate{}, err
+	}
+
+	return HijriDate{Year: year, Month: month, Day: day, Pattern: leapPattern}, nil
+}
+
+// ParseUmmAlQuraDate parses a formatted string into Umm al-Qura date, which must be within the
+// current Umm al-Qura table. See ParseHijriDate for the details.
+func ParseUmmAlQuraDate(layout, value string) (UmmAlQuraDate, error) {
+	return ParseUmmAlQuraDateLocale(layout, value, nil)
+}
+
+// ParseUmmAlQuraDateLocale is like ParseUmmAlQuraDate but uses the names from the locale, or English if
+// the locale is nil.
+func ParseUmmAlQuraDateLocale(layout, value string, locale *Locale) (UmmAlQuraDate, error) {
+	year, month, day, err := parseDate(layout, value, UmmAlQura, locale)
+	if err != nil {
+		return UmmAlQuraDate{}, err
+	}
+
+	return UmmAlQuraDate{Year: year, Month: month, Day: day}, nil
+}
+
+// ParseDate parses a formatted string into Hijri date in the specified calendar. See
+// ParseHijriDate for the details.
+func ParseDate(layout, value string, cal Calendar) (Date, error) {
+	return ParseDateLocale(layout, value, cal, nil)
+}
+
+// ParseDateLocale is like ParseDate but uses the names from the locale, or English if
+// the locale is nil.
+func ParseDateLocale(layout, value string, cal Calendar, locale *Locale) (Date, error) {
+	year, month, day, err := parseDate(layout, value, cal, locale)
+	if err != nil {
+		return Date{}, err
+	}
+
+	return Date{Year: year, Month: month, Day: day, Calendar: cal}, nil
+}
+
+func parseDate(layout, value string, cal Calendar, locale *Locale) (year, month, day int64, err error) {
+	locale = localeOrDefault(locale)
+	fullLayout, fullValue := layout, value
+	newError := func(offset int, layoutElem, message string) error {
+		valueElem := ""
+		if layoutElem != "" {
+			valueElem = fullValue[offset:]
+		}
+
+		return &ParseError{
+			Layout:     fullLayout,
+			Value:      fullValue,
+			Offset:     offset,
+			LayoutElem: layoutElem,
+			ValueElem:  valueElem,
+			Message:    message,
+		}
+	}
+
+	// Position of each field in value, used for the error message
+	var yearOffset, monthOffset, dayOffset, weekdayOffset, yearDayOffset int
+	weekday, yearDay := -1, int64(0)
+	for {
+		offset := len(fullValue) - len(value)
+		prefix, kind, suffix := nextToken(layout)
+		rest, ok := matchLiteral(value, prefix)
+		if !ok {
+			return 0, 0, 0, newError(offset, prefix, "")
+		}
+		value = rest
+
+		if kind == tokenNone {
+			break
+		}
+
+		tokenText := layout[len(prefix) : len(layout)-len(suffix)]
+		layout = suffix
+		offset = len(fullValue) - len(value)
+
+		var number int64
+		switch kind {
+		case tokenYear:
+			// Like time.Parse, the year has exactly four digits when a number follows it directly,
+			// e.g. in "20060102"
+			if next, nextKind, _ := nextToken(layout); next == "" && isNumberToken(nextKind) {
+				year, rest, ok = parseNumber(value, 4, 4, false)
+			} else {
+				year, rest, ok = parseNumber(value, 1, 0, false)
+			}
+		case tokenYear2:
+			number, rest, ok = parseNumber(value, 2, 2, false)
+			year = 1400 + number
+		case tokenZeroMonth:
+			month, rest, ok = parseNumber(value, 2, 2, false)
+		case tokenNumMonth:
+			month, rest, ok = parseNumber(value, 1, 2, false)
+		case tokenZeroDay:
+			day, rest, ok = parseNumber(value, 2, 2, false)
+		case tokenUnderDay:
+			day, rest, ok = parseNumber(value, 1, 2, true)
+		case tokenNumDay:
+			day, rest, ok = parseNumber(value, 1, 2, false)
+		case tokenZeroYearDay:
+			yearDay, rest, ok = parseNumber(value, 3, 3, false)
+		case tokenUnderYearDay:
+			yearDay, rest, ok = parseNumber(value, 1, 3, true)
+		case tokenLongMonth:
+			number, rest, ok = parseName(value, locale.Months[:])
+			month = number + 1
+		case tokenShortMonth:
+			number, rest, ok = parseName(value, locale.ShortMonths[:])
+			month = number + 1
+		case tokenLongWeekday:
+			number, rest, ok = parseName(value, locale.Weekdays[:])
+			weekday = int(number)
+		case tokenShortWeekday:
+			number, rest, ok = parseName(value, locale.ShortWeekdays[:])
+			weekday = int(number)
+		case tokenEra:
+			rest, ok = strings.TrimPrefix(value, locale.Era), strings.HasPrefix(value, locale.Era)
+		case tokenArabicEra:
+			rest, ok = strings.TrimPrefix(value, "هـ"), strings.HasPrefix(value, "هـ")
+		}
+
+		if !ok {
+			return 0, 0, 0, newError(offset, tokenText, "")
+		}
+		value = rest
+
+		switch kind {
+		case tokenYear, tokenYear2:
+			yearOffset = offset
+		case tokenLongMonth, tokenShortMonth, tokenZeroMonth, tokenNumMonth:
+			monthOffset = offset
+		case tokenZeroDay, tokenUnderDay, tokenNumDay:
+			dayOffset = offset
+		case tokenLongWeekday, tokenShortWeekday:
+			weekdayOffset = offset
+		case tokenZeroYearDay, tokenUnderYearDay:
+			yearDayOffset = offset
+		}
+	}
+
+	if value != "" {
+		return 0, 0, 0, newError(len(fullValue)-len(value), "", "extra text "+strconv.Quote(value))
+	}
+
+	// Validate the date using the month starts in the calendar, which only needs the start of the
+	// month and the next one, plus the start of year when the day of year is used
+	if year < 1 {
+		return 0, 0, 0, newError(yearOffset, "", "year out of range")
+	}
+
+	var newYear int64
+	cal = snapshotCalendar(cal)
+	if yearDay > 0 {
+		if newYear, err = lunationStart(cal, lunationNumber(year, 1)); err != nil {
+			return 0, 0, 0, newError(yearOffset, "", err.Error())
+		}
+	}
+
+	if month == 0 && yearDay > 0 {
+		month, day = 1, yearDay
+		monthOffset, dayOffset = yearDayOffset, yearDayOffset
+		for month < 12 {
+			nextStart, err := lunationStart(cal, lunationNumber(year, month+1))
+			if err != nil {
+				return 0, 0, 0, newError(yearOffset, "", err.Error())
+			}
+
+			if newYear+yearDay-1 < nextStart {
+				break
+			}
+
+			month++
+			day = newYear + yearDay - nextStart
+		}
+	}
+
+	if month < 1 || month > 12 {
+		return 0, 0, 0, newError(monthOffset, "", "month out of range")
+	}
+
+	iln := lunationNumber(year, month)
+	start, err := lunationStart(cal, iln)
+	if err != nil {
+		return 0, 0, 0, newError(yearOffset, "", err.Error())
+	}
+
+	nextStart, err := lunationStart(cal, iln+1)
+	if err != nil {
+		return 0, 0, 0, newError(yearOffset, "", err.Error())
+	}
+
+	if day < 1 || day > nextStart-start {
+		return 0, 0, 0, newError(dayOffset, "", "day out of range")
+	}
+
+	cjdn := start + day - 1
+	if yearDay > 0 && yearDay != cjdn-newYear+1 {
+		return 0, 0, 0, newError(yearDayOffset, "", "day of year doesn't match the date")
+	}
+
+	if weekday >= 0 && jdnWeekday(cjdn) != time.Weekday(weekday) {
+		return 0, 0, 0, newError(weekdayOffset, "", "weekday doesn't match the date")
+	}
+
+	return year, month, day, nil
+}
+
+// parseNumber parses a decimal number with the minimum and maximum count of digits, where zero
+// maximum means unlimited. The digits may use any digit system, e.g. "1447", "١٤٤٧" or "۱۴۴۷".
+// If spacePadded is true, the number may be preceded by spaces until it reaches the maximum width.
+func parseNumber(value string, minDigits, maxDigits int, spacePadded bool) (int64, string, bool) {
+	i := 0
+	if spacePadded {
+		for i < len(value) && i < maxDigits-1 && value[i] == ' ' {
+			i++
+		}
+		minDigits, maxDigits = 1, maxDigits-i
+	}
+
+	var number int64
+	var nDigits int
+	for i < len(value) && (maxDigits == 0 || nDigits < maxDigits) {
+		r, size := utf8.DecodeRuneInString(value[i:])
+		digit, ok := digitValue(r)
+		if !ok {
+			break
+		}
+
+		if number > (math.MaxInt64-digit)/10 {
+			return 0, value, false
+		}
+
+		number = number*10 + digit
+		nDigits++
+		i += size
+	}
+
+	if nDigits < minDigits {
+		return 0, value, false
+	}
+
+	return number, value[i:], true
+}
+
+// isBidiMark checks whether the rune is an invisible mark that controls the direction of text,
+// i.e. left-to-right mark, right-to-left mark or Arabic letter mark.
+func isBidiMark(r rune) bool {
+	return r == '\u200e' || r == '\u200f' || r == '\u061c'
+}
+
+// matchLiteral matches the literal text from layout with the start of value, and returns the rest
+// of value. Bidi marks are ignored in both of them, so the dates formatted in right-to-left locale
+// can be parsed using a plain layout and vice versa.
+func matchLiteral(value, literal string) (string, bool) {
+	for {
+		value = strings.TrimLeftFunc(value, isBidiMark)
+		literal = strings.TrimLeftFunc(literal, isBidiMark)
+		if literal == "" {
+			return value, true
+		}
+
+		r1, size1 := utf8.DecodeRuneInString(value)
+		r2, size2 := utf8.DecodeRuneInString(literal)
+		if value == "" || r1 != r2 {
+			return value, false
+		}
+
+		value, literal = value[size1:], literal[size2:]
+	}
+}
+
+// parseName finds the longest name that matches the start of value, ignoring the case. It returns
+// the index of the name.
+func parseName(value string, names []string) (int64, string, bool) {
+	index, length := -1, 0
+	for i, name := range names {
+		if len(name) > length && len(value) >= len(name) && strings.EqualFold(value[:len(name)], name) {
+			index, length = i, len(name)
+		}
+	}
+
+	if index < 0 {
+		return 0, value, false
+	}
+
+	return int64(index), value[length:], true
+}
@@ -0,0 +1,152 @@
+package hijri_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_Parse_Layouts(t *testing.T) {
+	tests := []struct {
+		Layout string
+		Value  string
+	}{
+		{hijri.LayoutISO, "1447-04-27"},
+		{"02/01/2006", "27/04/1447"},
+		{"2 January 2006 AH", "27 Rabi' al-Thani 1447 AH"},
+		{"2 January 2006 AH", "27 rabi' AL-THANI 1447 AH"},
+		{"Monday, 2 Jan 06", "Sunday, 27 Rab. II 47"},
+		{"_2-1-2006 هـ", "27-4-1447 هـ"},
+		{"2006 002", "1447 116"},
+		{"2006 __2", "1447 116"},
+		{"2006-01-02 002", "1447-04-27 116"},
+		{"20060102", "14470427"},
+		{"2006002", "1447116"},
+	}
+
+	for _, test := range tests {
+		result, err := hijri.ParseUmmAlQuraDate(test.Layout, test.Value)
+		if err != nil {
+			t.Errorf("%q: %v\n", test.Value, err)
+			continue
+		}
+
+		if result != (hijri.UmmAlQuraDate{Year: 1447, Month: 4, Day: 27}) {
+			t.Errorf("%q: want 1447-04-27 got %s\n", test.Value, result.Format(hijri.LayoutISO))
+		}
+	}
+
+	// Space padded numbers and arithmetic calendar
+	result, err := hijri.ParseHijriDate("_2 January 2006", " 5 Ramadan 1445", hijri.Base15)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result != (hijri.HijriDate{Year: 1445, Month: 9, Day: 5, Pattern: hijri.Base15}) {
+		t.Errorf("want 1445-09-05 got %s\n", result.Format(hijri.LayoutISO))
+	}
+
+	date, err := hijri.ParseDate(hijri.LayoutISO, "1445-10-01", hijri.NewMABIMSCalendar(hijri.Jakarta))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if gregorian := date.ToGregorian().Format("2006-01-02"); gregorian != "2024-04-10" {
+		t.Errorf("want 2024-04-10 got %s\n", gregorian)
+	}
+}
+
+func Test_Parse_RoundTrip(t *testing.T) {
+	layouts := []string{hijri.LayoutISO, hijri.LayoutLong, "Mon 2 Jan 2006 002", "__2 2006", "20060102"}
+	for year := int64(1444); year <= 1446; year++ {
+		for month := int64(1); month <= 12; month++ {
+			for day := int64(1); day <= 29; day += 7 {
+				date := hijri.HijriDate{Year: year, Month: month, Day: day}
+				for _, layout := range layouts {
+					value := date.Format(layout)
+					result, err := hijri.ParseHijriDate(layout, value, hijri.Default)
+					if err != nil {
+						t.Errorf("%q: %v\n", value, err)
+					} else if result != date {
+						t.Errorf("%q: want %s got %s\n", value, date.Format(layout), result.Format(layout))
+					}
+				}
+			}
+		}
+	}
+}
+
+func Test_Parse_Errors(t *testing.T) {
+	tests := []struct {
+		Layout  string
+		Value   string
+		Offset  int
+		Message string
+	}{
+		{hijri.LayoutISO, "1447/04/27", 4, `cannot parse "/04/27" as "-"`},
+		{hijri.LayoutISO, "1447-4-27", 5, `cannot parse "4-27" as "01"`},
+		{"2 January 2006", "27 Rajjab 1447", 3, `cannot parse "Rajjab 1447" as "January"`},
+		{hijri.LayoutISO, "1447-13-01", 5, "month out of range"},
+		{hijri.LayoutISO, "1447-04-31", 8, "day out of range"},
+		{hijri.LayoutISO, "0000-01-01", 0, "year out of range"},
+		{hijri.LayoutISO, "1447-04-27 AH", 10, `extra text " AH"`},
+		{"Monday 2006-01-02", "Monday 1447-04-27", 0, "weekday doesn't match the date"},
+		{"2006-01-02 002", "1447-04-27 117", 11, "day of year doesn't match the date"},
+		{hijri.LayoutISO, "1600-01-01", 0, "outside Umm al-Qura scope"},
+	}
+
+	for _, test := range tests {
+		_, err := hijri.ParseUmmAlQuraDate(test.Layout, test.Value)
+		parseErr, ok := err.(*hijri.ParseError)
+		if !ok {
+			t.Errorf("%q: want parse error got %v\n", test.Value, err)
+			continue
+		}
+
+		if parseErr.Offset != test.Offset || !strings.Contains(parseErr.Error(), test.Message) {
+			t.Errorf("%q: want %q at %d got %q at %d\n", test.Value, test.Message, test.Offset,
+				parseErr.Error(), parseErr.Offset)
+		}
+	}
+
+	// 30 Dhu al-Hijjah only exists in leap year
+	if _, err := hijri.ParseHijriDate(hijri.LayoutISO, "1445-12-30", hijri.Default); err != nil {
+		t.Errorf("1445 is a leap year: %v\n", err)
+	}
+
+	if _, err := hijri.ParseHijriDate(hijri.LayoutISO, "1446-12-30", hijri.Default); err == nil {
+		t.Errorf("1446 is not a leap year\n")
+	}
+}
+
+func Test_Parse_PartialCalendar(t *testing.T) {
+	// Only the parsed month and the next one are needed, so a table which only covers a few
+	// months of the year is enough
+	starts := []time.Time{
+		time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 4, 28, 0, 0, 0, 0, time.UTC),
+	}
+
+	cal, err := hijri.NewTableCalendar(1446, 9, starts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	date, err := hijri.ParseDate("Monday, 2006-01-02", "Saturday, 1446-09-29", cal)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if date.Year != 1446 || date.Month != 9 || date.Day != 29 {
+		t.Errorf("want 1446-09-29 got %04d-%02d-%02d\n", date.Year, date.Month, date.Day)
+	}
+
+	for _, value := range []string{"1446-09-30", "1446-10-30"} {
+		if _, err := hijri.ParseDate(hijri.LayoutISO, value, cal); err == nil {
+			t.Errorf("%s: want error got nil\n", value)
+		}
+	}
+}
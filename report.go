@@ -0,0 +1,232 @@
+package hijri
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hablullah/go-hijri/astro"
+)
+
+// Location is a named place used in the hilal report.
+type Location struct {
+	Name     string
+	Observer astro.Observer
+
+	// TimeZone is used to show the time in report. If it's nil, local mean time of the observer is
+	// used instead.
+	TimeZone *time.Location
+}
+
+// HilalReport is the ephemeris of the crescent (hilal) before a Hijri month starts, as usually
+// published by religious authorities for each city ("data hisab"). Each location is observed on
+// the evening of the local date of conjunction.
+type HilalReport struct {
+	Year        int64
+	Month       int64
+	Conjunction time.Time
+	Locations   []HilalLocation
+}
+
+// HilalLocation is the crescent ephemeris at a location. The angles are in degrees, and unless
+// mentioned otherwise they are measured at sunset.
+type HilalLocation struct {
+	Location Location
+	Date     time.Time
+	Sunset   time.Time
+	Moonset  time.Time
+	Lag      time.Duration
+	Age      time.Duration
+
+	Altitude     float64
+	Elongation   float64
+	Illumination float64
+
+	// AzimuthDifference is the azimuth of the Sun minus the azimuth of the Moon, in range
+	// [-180, 180).
+	AzimuthDifference float64
+}
+
+// NewHilalReport creates the crescent ephemeris for the specified Hijri month at each location.
+func NewHilalReport(year, month int64, locations []Location) (HilalReport, error) {
+	if month < 1 || month > 12 {
+		return HilalReport{}, fmt.Errorf("month must be between 1 and 12")
+	}
+
+	report := HilalReport{
+		Year:        year,
+		Month:       month,
+		Conjunction: Conjunction(year, month),
+		Locations:   make([]HilalLocation, len(locations)),
+	}
+
+	for i, location := range locations {
+		zone := location.zone()
+		conjunction := report.Conjunction.In(zone)
+		date := time.Date(conjunction.Year(), conjunction.Month(), conjunction.Day(), 0, 0, 0, 0, time.UTC)
+
+		crescent, err := ObserveCrescent(localDate(date, location.Observer, zone), location.Observer)
+		if err != nil {
+			return HilalReport{}, fmt.Errorf("%s: %v", location.Name, err)
+		}
+
+		report.Locations[i] = HilalLocation{
+			Location:          location,
+			Date:              date,
+			Sunset:            crescent.Sunset.In(zone),
+			Moonset:           crescent.Moonset.In(zone),
+			Lag:               crescent.Lag,
+			Age:               crescent.Age,
+			Altitude:          crescent.MoonAltitude,
+			Elongation:        crescent.Elongation,
+			Illumination:      crescent.Illumination,
+			AzimuthDifference: azimuthDifference(crescent.SunAzimuth, crescent.MoonAzimuth),
+		}
+	}
+
+	return report, nil
+}
+
+// MarshalJSON encodes the report location into JSON, with duration in minutes and time in
+// RFC 3339 format.
+func (hl HilalLocation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name              string  `json:"name"`
+		Latitude          float64 `json:"latitude"`
+		Longitude         float64 `json:"longitude"`
+		Elevation         float64 `json:"elevation"`
+		Date              string  `json:"date"`
+		Sunset            string  `json:"sunset"`
+		Moonset           string  `json:"moonset"`
+		Lag               float64 `json:"lag_minutes"`
+		Age               float64 `json:"age_hours"`
+		Altitude          float64 `json:"altitude"`
+		Elongation        float64 `json:"elongation"`
+		Illumination      float64 `json:"illumination"`
+		AzimuthDifference float64 `json:"azimuth_difference"`
+	}{
+		Name:              hl.Location.Name,
+		Latitude:          hl.Location.Observer.Latitude,
+		Longitude:         hl.Location.Observer.Longitude,
+		Elevation:         hl.Location.Observer.Elevation,
+		Date:              hl.Date.Format("2006-01-02"),
+		Sunset:            hl.Sunset.Format(time.RFC3339),
+		Moonset:           hl.Moonset.Format(time.RFC3339),
+		Lag:               roundTo(hl.Lag.Minutes(), 2),
+		Age:               roundTo(hl.Age.Hours(), 2),
+		Altitude:          roundTo(hl.Altitude, 4),
+		Elongation:        roundTo(hl.Elongation, 4),
+		Illumination:      roundTo(hl.Illumination, 6),
+		AzimuthDifference: roundTo(hl.AzimuthDifference, 4),
+	})
+}
+
+// MarshalJSON encodes the report into JSON.
+func (hr HilalReport) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Year        int64           `json:"year"`
+		Month       int64           `json:"month"`
+		Conjunction string          `json:"conjunction"`
+		Locations   []HilalLocation `json:"locations"`
+	}{
+		Year:        hr.Year,
+		Month:       hr.Month,
+		Conjunction: hr.Conjunction.UTC().Format(time.RFC3339),
+		Locations:   hr.Locations,
+	})
+}
+
+// WriteText writes the report as a plain text table, with time in each location's time zone and
+// angles in degrees, minutes and seconds.
+func (hr HilalReport) WriteText(w io.Writer) error {
+	ew := &errWriter{w: w}
+	ew.printf("Hijri month  : %04d-%02d\n", hr.Year, hr.Month)
+	ew.printf("Conjunction  : %s\n\n", hr.Conjunction.UTC().Format("2006-01-02 15:04:05 MST"))
+	if ew.err != nil {
+		return ew.err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "Location\tDate\tSunset\tMoonset\tLag\tAge\tAltitude\tElongation\tIllumination\tAz. Diff\t")
+	for _, hl := range hr.Locations {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%.2f%%\t%s\t\n",
+			hl.Location.Name,
+			hl.Date.Format("2006-01-02"),
+			hl.Sunset.Format("15:04:05"),
+			hl.Moonset.Format("15:04:05"),
+			formatDuration(hl.Lag),
+			formatDuration(hl.Age),
+			formatDMS(hl.Altitude),
+			formatDMS(hl.Elongation),
+			hl.Illumination*100,
+			formatDMS(hl.AzimuthDifference))
+	}
+
+	return tw.Flush()
+}
+
+// String returns the report as a plain text table.
+func (hr HilalReport) String() string {
+	buffer := bytes.NewBuffer(nil)
+	hr.WriteText(buffer)
+	return buffer.String()
+}
+
+// errWriter writes formatted text until the first error, so a long text can be written without
+// checking every line.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) printf(format string, args ...interface{}) {
+	if ew.err == nil {
+		_, ew.err = fmt.Fprintf(ew.w, format, args...)
+	}
+}
+
+func (l Location) zone() *time.Location {
+	if l.TimeZone != nil {
+		return l.TimeZone
+	}
+	return observerLocation(l.Observer)
+}
+
+// localDate converts a date in the location's time zone into the date in local mean time, which is
+// used by ObserveCrescent. Both of them are usually the same, except when the time zone is far from
+// the observer's longitude.
+func localDate(date time.Time, obs astro.Observer, zone *time.Location) time.Time {
+	noon := time.Date(date.Year(), date.Month(), date.Day(), 12, 0, 0, 0, zone)
+	return noon.In(observerLocation(obs))
+}
+
+// formatDuration formats the duration as hours and minutes, e.g. "+2h05m".
+func formatDuration(d time.Duration) string {
+	sign := "+"
+	if d < 0 {
+		sign, d = "-", -d
+	}
+
+	d = d.Round(time.Minute)
+	return fmt.Sprintf("%s%dh%02dm", sign, d/time.Hour, (d%time.Hour)/time.Minute)
+}
+
+// formatDMS formats the angle as degrees, minutes and seconds, e.g. "+2°05'09\"".
+func formatDMS(angle float64) string {
+	sign := "+"
+	if angle < 0 {
+		sign, angle = "-", -angle
+	}
+
+	seconds := int64(math.Round(angle * 3600))
+	return fmt.Sprintf("%s%d°%02d'%02d\"", sign, seconds/3600, seconds/60%60, seconds%60)
+}
+
+func roundTo(value float64, decimals int) float64 {
+	factor := math.Pow(10, float64(decimals))
+	return math.Round(value*factor) / factor
+}
@@ -0,0 +1,144 @@
+package hijri_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_Report_Shawwal1444(t *testing.T) {
+	wib := time.FixedZone("WIB", 7*60*60)
+	ast := time.FixedZone("AST", 3*60*60)
+	locations := []hijri.Location{
+		{Name: "Jakarta", Observer: hijri.Jakarta, TimeZone: wib},
+		{Name: "Mecca", Observer: hijri.Mecca, TimeZone: ast},
+	}
+
+	report, err := hijri.NewHilalReport(1444, 10, locations)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Conjunction happened on 20 April 2023 at 04:13 UTC
+	expected := time.Date(2023, 4, 20, 4, 13, 0, 0, time.UTC)
+	if diff := report.Conjunction.Sub(expected); diff < -time.Minute || diff > time.Minute {
+		t.Errorf("want conjunction %s got %s\n", expected, report.Conjunction)
+	}
+
+	for i, location := range report.Locations {
+		name := locations[i].Name
+		if location.Date.Format("2006-01-02") != "2023-04-20" {
+			t.Errorf("%s: want evening of 2023-04-20 got %s\n", name, location.Date.Format("2006-01-02"))
+		}
+
+		if location.Sunset.Location() != locations[i].TimeZone {
+			t.Errorf("%s: sunset is not in local time zone\n", name)
+		}
+
+		if location.Moonset.Sub(location.Sunset) != location.Lag {
+			t.Errorf("%s: lag %s doesn't match moonset\n", name, location.Lag)
+		}
+
+		if location.Illumination <= 0 || location.Illumination > 0.01 {
+			t.Errorf("%s: want thin crescent got illumination %f\n", name, location.Illumination)
+		}
+	}
+
+	// Sunset in Jakarta is at 17:50 WIB, and the moon is a bit more than 1° high
+	jakarta := report.Locations[0]
+	if result := jakarta.Sunset.Format("15:04"); result != "17:50" {
+		t.Errorf("Jakarta: want sunset 17:50 got %s\n", result)
+	}
+
+	if jakarta.Altitude < 1 || jakarta.Altitude > 2 {
+		t.Errorf("Jakarta: want altitude between 1° and 2° got %f\n", jakarta.Altitude)
+	}
+}
+
+func Test_Report_Output(t *testing.T) {
+	report, err := hijri.NewHilalReport(1445, 9, []hijri.Location{{Name: "Yogyakarta", Observer: hijri.Yogyakarta}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// JSON uses minutes for lag and hours for age
+	data, err := json.Marshal(report)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded struct {
+		Year      int64
+		Month     int64
+		Locations []map[string]interface{}
+	}
+
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.Year != 1445 || decoded.Month != 9 || len(decoded.Locations) != 1 {
+		t.Fatalf("invalid JSON report %s\n", data)
+	}
+
+	for _, key := range []string{"name", "sunset", "moonset", "lag_minutes", "age_hours",
+		"altitude", "elongation", "illumination", "azimuth_difference"} {
+		if _, exist := decoded.Locations[0][key]; !exist {
+			t.Errorf("JSON report has no %s\n", key)
+		}
+	}
+
+	lag := decoded.Locations[0]["lag_minutes"].(float64)
+	if diff := lag - report.Locations[0].Lag.Minutes(); diff < -0.01 || diff > 0.01 {
+		t.Errorf("want lag %f minutes got %f\n", report.Locations[0].Lag.Minutes(), lag)
+	}
+
+	// Text report has a header and a row for each location
+	text := report.String()
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	if len(lines) != 5 || !strings.Contains(lines[3], "Altitude") || !strings.Contains(lines[4], "Yogyakarta") {
+		t.Errorf("invalid text report:\n%s", text)
+	}
+}
+
+func Test_Report_WriteError(t *testing.T) {
+	report, err := hijri.NewHilalReport(1445, 9, []hijri.Location{{Name: "Yogyakarta", Observer: hijri.Yogyakarta}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decision, err := hijri.NewMABIMSCalendar(hijri.Jakarta).Explain(1444, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The error must be returned wherever the writer fails
+	for _, limit := range []int{0, 30, 200} {
+		if err := report.WriteText(&failingWriter{limit: limit}); err == nil {
+			t.Errorf("report: want error after %d bytes got nil\n", limit)
+		}
+
+		if err := decision.WriteText(&failingWriter{limit: limit}); err == nil {
+			t.Errorf("decision: want error after %d bytes got nil\n", limit)
+		}
+	}
+}
+
+// failingWriter fails after the limit of bytes has been written.
+type failingWriter struct {
+	limit   int
+	written int
+}
+
+func (fw *failingWriter) Write(p []byte) (int, error) {
+	if fw.written+len(p) > fw.limit {
+		return 0, errors.New("writer is full")
+	}
+
+	fw.written += len(p)
+	return len(p), nil
+}
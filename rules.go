@@ -0,0 +1,23 @@
+package hijri
+
+// MABIMS minimum altitude and elongation of the crescent at sunset, in degrees, as agreed by the
+// religious ministers of Brunei, Indonesia, Malaysia and Singapore in 2021.
+const (
+	MABIMSMinAltitude   = 3
+	MABIMSMinElongation = 6.4
+)
+
+// WujudulHilal returns true if the conjunction happened before sunset and the Moon sets after the
+// Sun, i.e. the Moon is still above the horizon at sunset no matter how low it is. This is the
+// rule used by Muhammadiyah in Indonesia.
+func WujudulHilal(crescent CrescentObservation) bool {
+	return crescent.Age > 0 && WujudulHilalCriterion.StartsMonth(crescent)
+}
+
+// MABIMS returns true if the conjunction happened before sunset and at sunset the crescent
+// altitude and its geocentric elongation are at least MABIMSMinAltitude and MABIMSMinElongation.
+// This is the neo-MABIMS imkanur rukyat rule, used by Indonesia, Malaysia, Brunei and Singapore
+// since 2022.
+func MABIMS(crescent CrescentObservation) bool {
+	return crescent.Age > 0 && MABIMSCriterion.StartsMonth(crescent)
+}
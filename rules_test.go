@@ -0,0 +1,69 @@
+package hijri_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_Rules_Indonesia(t *testing.T) {
+	// Month starts announced by Muhammadiyah (Wujudul Hilal) and Indonesian government (MABIMS)
+	tests := []struct {
+		Year         int64
+		Month        int64
+		WujudulHilal string
+		MABIMS       string
+	}{
+		{1444, 10, "2023-04-21", "2023-04-22"},
+		{1444, 12, "2023-06-19", "2023-06-20"},
+		{1445, 9, "2024-03-11", "2024-03-12"},
+		{1445, 10, "2024-04-10", "2024-04-10"},
+	}
+
+	wujudulHilal := hijri.NewWujudulHilalCalendar(hijri.Yogyakarta)
+	mabims := hijri.NewMABIMSCalendar(hijri.Jakarta)
+	for _, test := range tests {
+		start, err := wujudulHilal.MonthStart(test.Year, test.Month)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if result := start.Format("2006-01-02"); result != test.WujudulHilal {
+			t.Errorf("%04d-%02d: want %s got %s\n", test.Year, test.Month, test.WujudulHilal, result)
+		}
+
+		start, err = mabims.MonthStart(test.Year, test.Month)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if result := start.Format("2006-01-02"); result != test.MABIMS {
+			t.Errorf("%04d-%02d: want %s got %s\n", test.Year, test.Month, test.MABIMS, result)
+		}
+	}
+}
+
+func Test_Rules_Thresholds(t *testing.T) {
+	crescent := hijri.CrescentObservation{
+		Age:                  6 * time.Hour,
+		Lag:                  6 * time.Minute,
+		MoonAltitude:         0.6,
+		GeocentricElongation: 5.2,
+	}
+
+	if !hijri.WujudulHilal(crescent) || hijri.MABIMS(crescent) {
+		t.Errorf("low moon must pass Wujudul Hilal but fail MABIMS\n")
+	}
+
+	crescent.MoonAltitude, crescent.GeocentricElongation = 3, 6.4
+	if !hijri.MABIMS(crescent) {
+		t.Errorf("moon at the MABIMS limit must pass\n")
+	}
+
+	// Before conjunction, the moon above horizon doesn't count
+	crescent.Age = -time.Hour
+	if hijri.WujudulHilal(crescent) || hijri.MABIMS(crescent) {
+		t.Errorf("moon before conjunction must fail\n")
+	}
+}
@@ -0,0 +1,49 @@
+package hijri
+
+// UmmAlQuraAnnounced is Umm al-Qura calendar overlaid with the start of Ramadan, Shawwal and
+// Dhu al-Hijjah as announced by Saudi Arabia after crescent sighting. Those religious dates are
+// decided by the Supreme Court (previously the Supreme Judicial Council), so sometimes they are
+// different by a day with the civil Umm al-Qura calendar. Use its Divergences method to see when
+// that happened.
+//
+// Since the neighbours of the announced months are adjusted when it's created, it always uses the
+// default Umm al-Qura table regardless of SetUmmAlQuraTable. To overlay the announcements on
+// another table, use NewOverlayCalendar with that table as the base.
+//
+// The announcements are only applied when the calendar is used for the first time, so importing
+// this package never fails because of them.
+var UmmAlQuraAnnounced = newSaudiAnnouncedCalendar()
+
+func newSaudiAnnouncedCalendar() *OverlayCalendar {
+	oc := newLazyOverlayCalendar(defaultUmmAlQuraTable, saudiAnnouncements)
+	oc.Info.Name = "Saudi announcements"
+	oc.Info.Source = "Announcements of the Supreme Court of Saudi Arabia, published by Saudi Press Agency"
+	oc.Info.Revision = "sa-1446"
+	return oc
+}
+
+// saudiAnnouncements is the first day of Ramadan, Shawwal and Dhu al-Hijjah that announced by
+// Saudi authorities since 1426 H.
+var saudiAnnouncements = []TableEntry{
+	{"2005-10-04", "1426-09-01"}, {"2005-11-03", "1426-10-01"}, {"2006-01-01", "1426-12-01"},
+	{"2006-09-24", "1427-09-01"}, {"2006-10-23", "1427-10-01"}, {"2006-12-21", "1427-12-01"},
+	{"2007-09-13", "1428-09-01"}, {"2007-10-12", "1428-10-01"}, {"2007-12-10", "1428-12-01"},
+	{"2008-09-01", "1429-09-01"}, {"2008-09-30", "1429-10-01"}, {"2008-11-29", "1429-12-01"},
+	{"2009-08-22", "1430-09-01"}, {"2009-09-20", "1430-10-01"}, {"2009-11-18", "1430-12-01"},
+	{"2010-08-11", "1431-09-01"}, {"2010-09-10", "1431-10-01"}, {"2010-11-07", "1431-12-01"},
+	{"2011-08-01", "1432-09-01"}, {"2011-08-30", "1432-10-01"}, {"2011-10-28", "1432-12-01"},
+	{"2012-07-20", "1433-09-01"}, {"2012-08-19", "1433-10-01"}, {"2012-10-17", "1433-12-01"},
+	{"2013-07-10", "1434-09-01"}, {"2013-08-08", "1434-10-01"}, {"2013-10-06", "1434-12-01"},
+	{"2014-06-28", "1435-09-01"}, {"2014-07-28", "1435-10-01"}, {"2014-09-25", "1435-12-01"},
+	{"2015-06-18", "1436-09-01"}, {"2015-07-17", "1436-10-01"}, {"2015-09-15", "1436-12-01"},
+	{"2016-06-06", "1437-09-01"}, {"2016-07-06", "1437-10-01"}, {"2016-09-03", "1437-12-01"},
+	{"2017-05-27", "1438-09-01"}, {"2017-06-25", "1438-10-01"}, {"2017-08-23", "1438-12-01"},
+	{"2018-05-17", "1439-09-01"}, {"2018-06-15", "1439-10-01"}, {"2018-08-12", "1439-12-01"},
+	{"2019-05-06", "1440-09-01"}, {"2019-06-04", "1440-10-01"}, {"2019-08-02", "1440-12-01"},
+	{"2020-04-24", "1441-09-01"}, {"2020-05-24", "1441-10-01"}, {"2020-07-22", "1441-12-01"},
+	{"2021-04-13", "1442-09-01"}, {"2021-05-13", "1442-10-01"}, {"2021-07-11", "1442-12-01"},
+	{"2022-04-02", "1443-09-01"}, {"2022-05-02", "1443-10-01"}, {"2022-06-30", "1443-12-01"},
+	{"2023-03-23", "1444-09-01"}, {"2023-04-21", "1444-10-01"}, {"2023-06-19", "1444-12-01"},
+	{"2024-03-11", "1445-09-01"}, {"2024-04-10", "1445-10-01"}, {"2024-06-07", "1445-12-01"},
+	{"2025-03-01", "1446-09-01"}, {"2025-03-30", "1446-10-01"}, {"2025-05-28", "1446-12-01"},
+}
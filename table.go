@@ -0,0 +1,198 @@
+package hijri
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TableCalendar is a Hijri calendar which months are defined by a table of month starts, e.g. the
+// calendar that officially published by the religious authority of a country.
+type TableCalendar struct {
+	firstLunation int64
+	monthStarts   []int64
+}
+
+// TableEntry is a pair of Gregorian date and Hijri date that used to load TableCalendar, in format
+// YYYY-MM-DD for both of them.
+type TableEntry struct {
+	Gregorian string `json:"gregorian"`
+	Hijri     string `json:"hijri"`
+}
+
+// NewTableCalendar creates a calendar from a list of Gregorian dates when each month started, with
+// the first date as the start of the specified Hijri month. The months must be consecutive and has
+// either 29 or 30 days. The last date only marks the end of the table, so the calendar will cover
+// the months up to the one before it.
+func NewTableCalendar(year, month int64, monthStarts []time.Time) (*TableCalendar, error) {
+	if month < 1 || month > 12 {
+		return nil, errors.New("month must be between 1 and 12")
+	}
+
+	if len(monthStarts) < 2 {
+		return nil, errors.New("table needs at least two month starts")
+	}
+
+	cal := &TableCalendar{
+		firstLunation: lunationNumber(year, month),
+		monthStarts:   make([]int64, len(monthStarts)),
+	}
+
+	for i, start := range monthStarts {
+		cjdn, err := timeToJDN(start)
+		if err != nil {
+			return nil, err
+		}
+
+		if i > 0 {
+			if nDays := cjdn - cal.monthStarts[i-1]; nDays != 29 && nDays != 30 {
+				y, m := lunationMonth(cal.firstLunation + int64(i) - 1)
+				return nil, fmt.Errorf("month %04d-%02d has %d days", y, m, nDays)
+			}
+		}
+
+		cal.monthStarts[i] = cjdn
+	}
+
+	return cal, nil
+}
+
+// NewTableCalendarFromEntries creates a calendar from list of Gregorian and Hijri date pairs. The
+// entries may list every day or only the first day of each month, but every month between the
+// first and last entry must be present. Since it's impossible to know whether the last month is
+// already complete, the month of the last entry only marks the end of the table.
+func NewTableCalendarFromEntries(entries []TableEntry) (*TableCalendar, error) {
+	// Find the start of each month from the entries
+	monthStarts := map[int64]int64{}
+	for _, entry := range entries {
+		gregorianDate, err := time.Parse("2006-01-02", strings.TrimSpace(entry.Gregorian))
+		if err != nil {
+			return nil, err
+		}
+
+		year, month, day, err := parseHijriEntry(entry.Hijri)
+		if err != nil {
+			return nil, err
+		}
+
+		cjdn, err := timeToJDN(gregorianDate)
+		if err != nil {
+			return nil, err
+		}
+
+		iln := lunationNumber(year, month)
+		start := cjdn - day + 1
+		if existing, ok := monthStarts[iln]; ok && existing != start {
+			return nil, fmt.Errorf("entry %s conflicts with the previous entries", entry.Hijri)
+		}
+
+		monthStarts[iln] = start
+	}
+
+	// Sort the months, then make sure there are no gap between them
+	lunations := make([]int64, 0, len(monthStarts))
+	for iln := range monthStarts {
+		lunations = append(lunations, iln)
+	}
+
+	sort.Slice(lunations, func(a, b int) bool {
+		return lunations[a] < lunations[b]
+	})
+
+	starts := make([]time.Time, len(lunations))
+	for i, iln := range lunations {
+		if i > 0 && iln != lunations[i-1]+1 {
+			year, month := lunationMonth(lunations[i-1] + 1)
+			return nil, fmt.Errorf("month %04d-%02d is missing", year, month)
+		}
+
+		starts[i] = jdnToTime(monthStarts[iln])
+	}
+
+	if len(lunations) == 0 {
+		return nil, errors.New("table has no entries")
+	}
+
+	year, month := lunationMonth(lunations[0])
+	return NewTableCalendar(year, month, starts)
+}
+
+// LoadTableCalendarCSV creates a calendar from CSV data, where each record is a Gregorian date
+// followed by its Hijri date, e.g. "1990-01-01,1410-06-04".
+func LoadTableCalendarCSV(r io.Reader) (*TableCalendar, error) {
+	entries := []TableEntry{}
+	csvReader := csv.NewReader(r)
+	csvReader.FieldsPerRecord = 2
+
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, TableEntry{
+			Gregorian: record[0],
+			Hijri:     record[1],
+		})
+	}
+
+	return NewTableCalendarFromEntries(entries)
+}
+
+// LoadTableCalendarJSON creates a calendar from JSON data, which is an array of objects with
+// Gregorian and Hijri date, e.g. [{"gregorian": "1990-01-01", "hijri": "1410-06-04"}].
+func LoadTableCalendarJSON(r io.Reader) (*TableCalendar, error) {
+	var entries []TableEntry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, err
+	}
+
+	return NewTableCalendarFromEntries(entries)
+}
+
+// MonthStart returns the Gregorian date of the first day of the specified Hijri month.
+func (tc *TableCalendar) MonthStart(year, month int64) (time.Time, error) {
+	if month < 1 || month > 12 {
+		return time.Time{}, errors.New("month must be between 1 and 12")
+	}
+
+	idx := lunationNumber(year, month) - tc.firstLunation
+	if idx < 0 || idx >= int64(len(tc.monthStarts)) {
+		return time.Time{}, errors.New("month is outside the calendar table")
+	}
+
+	return jdnToTime(tc.monthStarts[idx]), nil
+}
+
+func parseHijriEntry(str string) (year, month, day int64, err error) {
+	parts := strings.Split(strings.TrimSpace(str), "-")
+	if len(parts) != 3 {
+		err = fmt.Errorf("invalid hijri date %q", str)
+		return
+	}
+
+	var values [3]int64
+	for i, part := range parts {
+		if values[i], err = strconv.ParseInt(part, 10, 64); err != nil {
+			err = fmt.Errorf("invalid hijri date %q", str)
+			return
+		}
+	}
+
+	year, month, day = values[0], values[1], values[2]
+	if month < 1 || month > 12 || day < 1 || day > 30 {
+		err = fmt.Errorf("invalid hijri date %q", str)
+	}
+
+	return
+}
@@ -0,0 +1,144 @@
+package hijri_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_TableCalendar_LoadCSV(t *testing.T) {
+	f, err := os.Open("test/ummalqura.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	cal, err := hijri.LoadTableCalendarCSV(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The last month in test data is incomplete, so it only marks the end of table
+	for _, data := range ummAlQuraTestData {
+		if strings.HasPrefix(data.Hijri, "1473-04") {
+			break
+		}
+
+		gregorianDate, _ := time.Parse("2006-01-02", data.Gregorian)
+		date, err := hijri.CreateDate(gregorianDate, cal)
+		if err != nil {
+			t.Fatalf("%s: %v\n", data.Gregorian, err)
+		}
+
+		result := fmt.Sprintf("%04d-%02d-%02d", date.Year, date.Month, date.Day)
+		if result != data.Hijri {
+			t.Errorf("%s: want %s got %s\n", data.Gregorian, data.Hijri, result)
+		}
+
+		if result := date.ToGregorian().Format("2006-01-02"); result != data.Gregorian {
+			t.Errorf("%s: want %s got %s\n", data.Hijri, data.Gregorian, result)
+		}
+	}
+
+	if _, err := hijri.Months(cal, 1473); err == nil {
+		t.Error("1473: want error got nil")
+	}
+}
+
+func Test_TableCalendar_LoadJSON(t *testing.T) {
+	data := `[
+		{"gregorian": "2025-02-28", "hijri": "1446-08-30"},
+		{"gregorian": "2025-03-01", "hijri": "1446-09-01"},
+		{"gregorian": "2025-03-30", "hijri": "1446-10-01"},
+		{"gregorian": "2025-04-28", "hijri": "1446-11-01"}
+	]`
+
+	cal, err := hijri.LoadTableCalendarJSON(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lengths := []int64{30, 29, 29}
+	for i, length := range lengths {
+		month := int64(i + 8)
+		start, _ := cal.MonthStart(1446, month)
+		end, _ := cal.MonthStart(1446, month+1)
+		if nDays := int64(end.Sub(start).Hours() / 24); nDays != length {
+			t.Errorf("1446-%02d: want %d days got %d\n", month, length, nDays)
+		}
+	}
+}
+
+func Test_TableCalendar_Bounds(t *testing.T) {
+	// Every day in the first and last month of a table must be found, even when the estimated
+	// month is just outside the table. Here each table only has a single month, taken from the
+	// default Umm al-Qura table which starts from 1356 H.
+	for year := int64(1356); year <= 1500; year++ {
+		for month := int64(1); month <= 12; month++ {
+			if year == 1356 && month < 3 {
+				continue
+			}
+
+			nextYear, nextMonth := year, month+1
+			if nextMonth > 12 {
+				nextYear, nextMonth = year+1, 1
+			}
+
+			start, err := hijri.UmmAlQura.MonthStart(year, month)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			end, err := hijri.UmmAlQura.MonthStart(nextYear, nextMonth)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The early Umm al-Qura table has a few months which are not 29 or 30 days
+			cal, err := hijri.NewTableCalendar(year, month, []time.Time{start, end})
+			if err != nil {
+				continue
+			}
+
+			for date, day := start, int64(1); date.Before(end); date, day = date.AddDate(0, 0, 1), day+1 {
+				result, err := hijri.CreateDate(date, cal)
+				if err != nil {
+					t.Errorf("%s: %v\n", date.Format("2006-01-02"), err)
+				} else if result.Year != year || result.Month != month || result.Day != day {
+					t.Errorf("%s: want %04d-%02d-%02d got %04d-%02d-%02d\n", date.Format("2006-01-02"),
+						year, month, day, result.Year, result.Month, result.Day)
+				}
+			}
+
+			// Days around the table are still outside
+			for _, date := range []time.Time{start.AddDate(0, 0, -1), end} {
+				if _, err := hijri.CreateDate(date, cal); err == nil {
+					t.Errorf("%s: want error got nil\n", date.Format("2006-01-02"))
+				}
+			}
+		}
+	}
+}
+
+func Test_TableCalendar_Invalid(t *testing.T) {
+	invalidData := []string{
+		// Conflicting entries
+		"2025-03-01,1446-09-01\n2025-03-02,1446-09-01\n",
+		// Missing month
+		"2025-03-01,1446-09-01\n2025-04-28,1446-11-01\n",
+		// Month with 31 days
+		"2025-03-01,1446-09-01\n2025-04-01,1446-10-01\n",
+		// Invalid Hijri date
+		"2025-03-01,1446-13-01\n2025-03-30,1446-10-01\n",
+	}
+
+	for _, data := range invalidData {
+		if _, err := hijri.LoadTableCalendarCSV(strings.NewReader(data)); err == nil {
+			t.Errorf("want error for %q\n", data)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package hijri
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+)
+
+// TableInfo is the provenance of a calendar table, so it's possible to tell which revision of
+// the data that used in a conversion.
+type TableInfo struct {
+	// Name is the name of the table, e.g. "Umm al-Qura".
+	Name string
+
+	// Source is the publisher or reference where the data is taken from.
+	Source string
+
+	// Revision is the identifier of this version of the table.
+	Revision string
+
+	// Checksum is the hex encoded SHA-256 of the table, written in the same CSV format as the one
+	// used by LoadTableCalendarCSV, i.e. one "gregorian,hijri" record per line.
+	Checksum string
+}
+
+var defaultUmmAlQuraTable = &UmmAlQuraTable{
+	Info: TableInfo{
+		Name:     "Umm al-Qura",
+		Source:   "R.H. van Gent, The Umm al-Qura Calendar of Saudi Arabia (https://webspace.science.uu.nl/~gent0113/islam/ummalqura.htm)",
+		Revision: "vangent-2019",
+		Checksum: "49ee962f10286004a725c5bdb954c45dcb7d39a0991b3bf80182aa8aed44995b",
+	},
+	lunationOffset: 16260,
+	lunationMCJDN:  ummalQuraLunationMCJDN,
+}
+
+var ummAlQuraTables = struct {
+	sync.RWMutex
+	list    []*UmmAlQuraTable
+	current *UmmAlQuraTable
+}{
+	list:    []*UmmAlQuraTable{defaultUmmAlQuraTable},
+	current: defaultUmmAlQuraTable,
+}
+
+// UmmAlQuraTables returns the info of every registered Umm al-Qura table.
+func UmmAlQuraTables() []TableInfo {
+	ummAlQuraTables.RLock()
+	defer ummAlQuraTables.RUnlock()
+
+	infos := make([]TableInfo, len(ummAlQuraTables.list))
+	for i, table := range ummAlQuraTables.list {
+		infos[i] = table.Info
+	}
+
+	return infos
+}
+
+// LookupUmmAlQuraTable returns the registered Umm al-Qura table with the specified revision.
+func LookupUmmAlQuraTable(revision string) (*UmmAlQuraTable, error) {
+	ummAlQuraTables.RLock()
+	defer ummAlQuraTables.RUnlock()
+
+	for _, table := range ummAlQuraTables.list {
+		if table.Info.Revision == revision {
+			return table, nil
+		}
+	}
+
+	return nil, fmt.Errorf("umm al-qura table %q is not registered", revision)
+}
+
+// RegisterUmmAlQuraTable registers another revision of Umm al-Qura table, e.g. an older one that
+// needed to reproduce archived documents. The revision must not registered yet.
+func RegisterUmmAlQuraTable(table *UmmAlQuraTable) error {
+	ummAlQuraTables.Lock()
+	defer ummAlQuraTables.Unlock()
+
+	for _, registered := range ummAlQuraTables.list {
+		if registered.Info.Revision == table.Info.Revision {
+			return fmt.Errorf("umm al-qura table %q is already registered", table.Info.Revision)
+		}
+	}
+
+	ummAlQuraTables.list = append(ummAlQuraTables.list, table)
+	return nil
+}
+
+// UnregisterUmmAlQuraTable removes the registered Umm al-Qura table with the specified revision.
+// The default table and the table that currently used can't be removed.
+func UnregisterUmmAlQuraTable(revision string) error {
+	ummAlQuraTables.Lock()
+	defer ummAlQuraTables.Unlock()
+
+	for i, table := range ummAlQuraTables.list {
+		if table.Info.Revision != revision {
+			continue
+		}
+
+		if table == defaultUmmAlQuraTable || table == ummAlQuraTables.current {
+			return fmt.Errorf("umm al-qura table %q is still used", revision)
+		}
+
+		ummAlQuraTables.list = append(ummAlQuraTables.list[:i], ummAlQuraTables.list[i+1:]...)
+		return nil
+	}
+
+	return fmt.Errorf("umm al-qura table %q is not registered", revision)
+}
+
+// SetUmmAlQuraTable selects the registered Umm al-Qura table that used by CreateUmmAlQuraDate,
+// UmmAlQuraDate and UmmAlQura calendar. By default they use the latest embedded table.
+func SetUmmAlQuraTable(revision string) error {
+	table, err := LookupUmmAlQuraTable(revision)
+	if err != nil {
+		return err
+	}
+
+	ummAlQuraTables.Lock()
+	ummAlQuraTables.current = table
+	ummAlQuraTables.Unlock()
+	return nil
+}
+
+// CurrentUmmAlQuraTable returns the info of Umm al-Qura table that currently used.
+func CurrentUmmAlQuraTable() TableInfo {
+	return currentUmmAlQuraTable().Info
+}
+
+func currentUmmAlQuraTable() *UmmAlQuraTable {
+	ummAlQuraTables.RLock()
+	defer ummAlQuraTables.RUnlock()
+	return ummAlQuraTables.current
+}
+
+// tableChecksum calculates SHA-256 of the entries in CSV format.
+func tableChecksum(entries []TableEntry) string {
+	hash := sha256.New()
+	for _, entry := range entries {
+		fmt.Fprintf(hash, "%s,%s\n", entry.Gregorian, entry.Hijri)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
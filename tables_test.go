@@ -0,0 +1,135 @@
+package hijri_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_Tables_Checksum(t *testing.T) {
+	// Rebuild the default Umm al-Qura table from its month starts
+	info := hijri.CurrentUmmAlQuraTable()
+	var starts []time.Time
+	for year := int64(1356); year <= 1501; year++ {
+		start, _ := hijri.UmmAlQura.MonthStart(year, 1)
+		if year == 1501 {
+			starts = append(starts, start)
+			break
+		}
+
+		months, err := hijri.Months(hijri.UmmAlQura, year)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, month := range months {
+			starts = append(starts, month.Start)
+		}
+	}
+
+	table, err := hijri.NewUmmAlQuraTable(hijri.TableInfo{}, 1356, 1, starts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if table.Info.Checksum != info.Checksum {
+		t.Errorf("Umm al-Qura: want checksum %s got %s\n", info.Checksum, table.Info.Checksum)
+	}
+
+	expected := "6a2925b26d32424717421ed223b143cc4d9c5adb8dbccb33f2e919d2148ea6dd"
+	if checksum := hijri.UmmAlQuraAnnounced.Info.Checksum; checksum != expected {
+		t.Errorf("Saudi announcements: want checksum %s got %s\n", expected, checksum)
+	}
+}
+
+func Test_Tables_SetUmmAlQuraTable(t *testing.T) {
+	defaultRevision := hijri.CurrentUmmAlQuraTable().Revision
+	nTables := len(hijri.UmmAlQuraTables())
+
+	// Register a made-up revision where Ramadan 1446 H started a day later
+	starts := []time.Time{
+		time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	info := hijri.TableInfo{Name: "Umm al-Qura", Source: "test", Revision: "test-1446"}
+	table, err := hijri.NewUmmAlQuraTable(info, 1446, 8, starts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = hijri.RegisterUmmAlQuraTable(table); err != nil {
+		t.Fatal(err)
+	}
+
+	// Restore the previous table before removing the test table, so the test can be repeated
+	defer func() {
+		if err := hijri.SetUmmAlQuraTable(defaultRevision); err != nil {
+			t.Error(err)
+		}
+
+		if err := hijri.UnregisterUmmAlQuraTable("test-1446"); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	if err = hijri.RegisterUmmAlQuraTable(table); err == nil {
+		t.Error("duplicate revision: want error got nil")
+	}
+
+	if err = hijri.SetUmmAlQuraTable("unknown"); err == nil {
+		t.Error("unknown revision: want error got nil")
+	}
+
+	// Convert date using the new revision, then with the default one
+	date := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	expected := map[string]int64{"test-1446": 8, defaultRevision: 9}
+	for _, revision := range []string{"test-1446", defaultRevision} {
+		if err = hijri.SetUmmAlQuraTable(revision); err != nil {
+			t.Fatal(err)
+		}
+
+		uqDate, err := hijri.CreateUmmAlQuraDate(date)
+		if err != nil {
+			t.Fatalf("%s: %v\n", revision, err)
+		}
+
+		if uqDate.Month != expected[revision] {
+			t.Errorf("%s: want month %d got %d\n", revision, expected[revision], uqDate.Month)
+		}
+	}
+
+	// Dates outside the short table don't panic, and the announced calendar keeps the default table
+	if err = hijri.SetUmmAlQuraTable("test-1446"); err != nil {
+		t.Fatal(err)
+	}
+
+	outside := hijri.UmmAlQuraDate{Year: 1447, Month: 1, Day: 1}
+	if !outside.ToGregorian().IsZero() || outside.YearDay() != 0 {
+		t.Errorf("1447-01-01: want zero time and year day\n")
+	}
+
+	if err := outside.Validate(); err == nil {
+		t.Errorf("1447-01-01: want error got nil\n")
+	}
+
+	// The table in use can't be removed
+	if err := hijri.UnregisterUmmAlQuraTable("test-1446"); err == nil {
+		t.Errorf("current table: want error got nil\n")
+	}
+
+	if err := hijri.UnregisterUmmAlQuraTable(defaultRevision); err == nil {
+		t.Errorf("default table: want error got nil\n")
+	}
+
+	announced, err := hijri.CreateDate(date, hijri.UmmAlQuraAnnounced)
+	if err != nil || announced.Month != 9 {
+		t.Errorf("announced calendar: want month 9 got %d (%v)\n", announced.Month, err)
+	}
+
+	if len(hijri.UmmAlQuraTables()) != nTables+1 {
+		t.Errorf("want %d tables got %d\n", nTables+1, len(hijri.UmmAlQuraTables()))
+	}
+}
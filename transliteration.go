@@ -0,0 +1,101 @@
+package hijri
+
+// Transliteration is the scheme used to write the Arabic names of months and weekdays in Latin
+// script.
+type Transliteration int
+
+const (
+	// PopularTransliteration is the common English spelling, e.g. "Rabi' al-Awwal", which is the
+	// same as the English locale.
+	PopularTransliteration Transliteration = iota
+
+	// ASCIITransliteration is the popular spelling without apostrophes or diacritics, e.g.
+	// "Rabi al-Awwal", which is suitable for user interface and identifiers. Like the popular
+	// spelling, it keeps the English weekdays.
+	ASCIITransliteration
+
+	// ALALCTransliteration is the romanization of the American Library Association and Library of
+	// Congress, e.g. "Rabīʿ al-Awwal" and "Dhū al-Ḥijjah".
+	ALALCTransliteration
+
+	// IJMESTransliteration is the system of International Journal of Middle East Studies, e.g.
+	// "Rabīʿ al-Awwal" and "Dhū al-Ḥijja".
+	IJMESTransliteration
+)
+
+// transliterations is the English-script locale of each transliteration scheme.
+var transliterations = map[Transliteration]Locale{
+	PopularTransliteration: english,
+	ASCIITransliteration: {
+		Tag: "en",
+		Months: [12]string{
+			"Muharram", "Safar", "Rabi al-Awwal", "Rabi al-Thani", "Jumada al-Ula", "Jumada al-Akhirah",
+			"Rajab", "Shaban", "Ramadan", "Shawwal", "Dhu al-Qidah", "Dhu al-Hijjah",
+		},
+		ShortMonths:   ShortMonthNames,
+		Weekdays:      english.Weekdays,
+		ShortWeekdays: english.ShortWeekdays,
+		Era:           "AH",
+	},
+	ALALCTransliteration: {
+		Tag: "en",
+		Months: [12]string{
+			"Muḥarram", "Ṣafar", "Rabīʿ al-Awwal", "Rabīʿ al-Thānī", "Jumādá al-Ūlá", "Jumādá al-Ākhirah",
+			"Rajab", "Shaʿbān", "Ramaḍān", "Shawwāl", "Dhū al-Qaʿdah", "Dhū al-Ḥijjah",
+		},
+		ShortMonths: [12]string{
+			"Muḥ.", "Ṣaf.", "Rab. I", "Rab. II", "Jum. I", "Jum. II",
+			"Raj.", "Shaʿ.", "Ram.", "Shaw.", "Dhū al-Q.", "Dhū al-Ḥ.",
+		},
+		Weekdays: [7]string{
+			"al-Aḥad", "al-Ithnayn", "al-Thulāthāʾ", "al-Arbiʿāʾ", "al-Khamīs", "al-Jumʿah", "al-Sabt",
+		},
+		ShortWeekdays: [7]string{"Aḥad", "Ithn.", "Thul.", "Arb.", "Kham.", "Jumʿ.", "Sabt"},
+		Era:           "AH",
+	},
+	IJMESTransliteration: {
+		Tag: "en",
+		Months: [12]string{
+			"Muḥarram", "Ṣafar", "Rabīʿ al-Awwal", "Rabīʿ al-Thānī", "Jumādā al-Ūlā", "Jumādā al-Ākhira",
+			"Rajab", "Shaʿbān", "Ramaḍān", "Shawwāl", "Dhū al-Qaʿda", "Dhū al-Ḥijja",
+		},
+		ShortMonths: [12]string{
+			"Muḥ.", "Ṣaf.", "Rab. I", "Rab. II", "Jum. I", "Jum. II",
+			"Raj.", "Shaʿ.", "Ram.", "Shaw.", "Dhū al-Q.", "Dhū al-Ḥ.",
+		},
+		Weekdays: [7]string{
+			"al-Aḥad", "al-Ithnayn", "al-Thulāthāʾ", "al-Arbiʿāʾ", "al-Khamīs", "al-Jumʿa", "al-Sabt",
+		},
+		ShortWeekdays: [7]string{"Aḥad", "Ithn.", "Thul.", "Arb.", "Kham.", "Jumʿ.", "Sabt"},
+		Era:           "AH",
+	},
+}
+
+// String returns the name of the transliteration scheme.
+func (t Transliteration) String() string {
+	switch t {
+	case PopularTransliteration:
+		return "Popular"
+	case ASCIITransliteration:
+		return "ASCII"
+	case ALALCTransliteration:
+		return "ALA-LC"
+	case IJMESTransliteration:
+		return "IJMES"
+	default:
+		return "unknown"
+	}
+}
+
+// Locale returns the English-script locale where the Arabic names of months are written using the
+// transliteration scheme. ALA-LC and IJMES also use the Arabic names of weekdays as in academic
+// writing, e.g. "al-Jumʿa, 1 Ramaḍān 1446 AH", while the popular and ASCII spelling keep the
+// English weekdays, e.g. "Sunday, 1 Rabi al-Awwal 1447 AH". Unknown scheme will use the popular
+// spelling.
+func (t Transliteration) Locale() *Locale {
+	locale, ok := transliterations[t]
+	if !ok {
+		locale = transliterations[PopularTransliteration]
+	}
+	return &locale
+}
@@ -0,0 +1,66 @@
+package hijri_test
+
+import (
+	"testing"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_Transliteration_Format(t *testing.T) {
+	// 10 Dhu al-Hijjah 1446 H is Friday, 6 June 2025
+	date := hijri.UmmAlQuraDate{Year: 1446, Month: 12, Day: 10}
+	tests := []struct {
+		Scheme   hijri.Transliteration
+		Expected string
+	}{
+		{hijri.PopularTransliteration, "Friday, 10 Dhu al-Hijjah 1446 AH"},
+		{hijri.ASCIITransliteration, "Friday, 10 Dhu al-Hijjah 1446 AH"},
+		{hijri.ALALCTransliteration, "al-Jumʿah, 10 Dhū al-Ḥijjah 1446 AH"},
+		{hijri.IJMESTransliteration, "al-Jumʿa, 10 Dhū al-Ḥijja 1446 AH"},
+	}
+
+	for _, test := range tests {
+		locale := test.Scheme.Locale()
+		value := date.FormatLocale(hijri.LayoutLong, locale)
+		if value != test.Expected {
+			t.Errorf("%s: want %q got %q\n", test.Scheme, test.Expected, value)
+		}
+
+		result, err := hijri.ParseUmmAlQuraDateLocale(hijri.LayoutLong, value, locale)
+		if err != nil {
+			t.Errorf("%s: %v\n", test.Scheme, err)
+		} else if result != date {
+			t.Errorf("%s: want %v got %v\n", test.Scheme, date, result)
+		}
+	}
+
+	// Names with diacritics are parsed case-insensitively
+	result, err := hijri.ParseHijriDateLocale("2 January 2006", "12 RABĪʿ AL-AWWAL 1447",
+		hijri.Default, hijri.ALALCTransliteration.Locale())
+	if err != nil || result.Month != 3 {
+		t.Errorf("want Rabīʿ al-Awwal got %v (%v)\n", result, err)
+	}
+
+	// ASCII names must not contain any non-ASCII character
+	ascii := hijri.ASCIITransliteration.Locale()
+	for _, names := range [][]string{ascii.Months[:], ascii.ShortMonths[:], ascii.Weekdays[:], ascii.ShortWeekdays[:]} {
+		for _, name := range names {
+			for _, r := range name {
+				if r > 127 || r == '\'' {
+					t.Errorf("%q is not plain ASCII\n", name)
+					break
+				}
+			}
+		}
+	}
+
+	// Popular spelling is the same as English
+	english, _ := hijri.LookupLocale("en")
+	if popular := hijri.PopularTransliteration.Locale(); *popular != *english {
+		t.Errorf("want popular spelling same as English\n")
+	}
+
+	if name := hijri.Transliteration(99).String(); name != "unknown" {
+		t.Errorf("want unknown got %s\n", name)
+	}
+}
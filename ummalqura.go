@@ -2,6 +2,7 @@ package hijri
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"time"
 
@@ -10,25 +11,209 @@ import (
 
 // UmmAlQuraDate is a date that uses astronomical-based Islamic calendar system that used in Saudi Arabia.
 type UmmAlQuraDate struct {
-	Day     int64
-	Month   int64
-	Year    int64
-	Weekday time.Weekday
+	Day   int64
+	Month int64
+	Year  int64
 }
 
-// CreateUmmAlQuraDate converts Gregorian date to Umm al-Qura date.
+// CreateUmmAlQuraDate converts Gregorian date to Umm al-Qura date, using the current Umm al-Qura
+// table (see SetUmmAlQuraTable).
 func CreateUmmAlQuraDate(date time.Time) (UmmAlQuraDate, error) {
+	return currentUmmAlQuraTable().createDate(date)
+}
+
+// ToGregorian convert Umm al-Qura date to Gregorian date using Golang standard time. If the month
+// is outside the current Umm al-Qura table, it will returns zero time, so use Validate to check the
+// date first.
+func (uq UmmAlQuraDate) ToGregorian() time.Time {
+	cjdn, err := uq.julianDayNumber()
+	if err != nil {
+		return time.Time{}
+	}
+
+	return jdnToTime(cjdn)
+}
+
+// Validate checks whether the date exists in the current Umm al-Qura table, i.e. the month is
+// between 1 and 12, the month is within the table, and the day is within the length of the month.
+func (uq UmmAlQuraDate) Validate() error {
+	if uq.Month < 1 || uq.Month > 12 {
+		return errors.New("month must be between 1 and 12")
+	}
+
+	table := currentUmmAlQuraTable()
+	start, err := table.MonthStart(uq.Year, uq.Month)
+	if err != nil {
+		return err
+	}
+
+	nextYear, nextMonth := uq.Year, uq.Month+1
+	if nextMonth > 12 {
+		nextYear, nextMonth = nextYear+1, 1
+	}
+
+	nextStart, err := table.MonthStart(nextYear, nextMonth)
+	if err != nil {
+		return err
+	}
+
+	nDays := int64(nextStart.Sub(start).Hours() / 24)
+	if uq.Day < 1 || uq.Day > nDays {
+		return errors.New("day is outside the month")
+	}
+
+	return nil
+}
+
+// Weekday returns the day of the week of this Umm al-Qura date. If the month is outside the
+// current Umm al-Qura table, it will returns Sunday, so use Validate to check the date first.
+func (uq UmmAlQuraDate) Weekday() time.Weekday {
+	weekday, _ := uq.weekday()
+	return weekday
+}
+
+// YearDay returns the day of the year of this Umm al-Qura date, in the range [1, 355]. If the
+// year is outside the current Umm al-Qura table, it will returns zero which is never a valid day.
+func (uq UmmAlQuraDate) YearDay() int64 {
+	yearDay, _ := uq.yearDay()
+	return yearDay
+}
+
+// Week returns the week number of this Umm al-Qura date, in the range [1, 52]. The first week
+// of the year is the week that contains 1 Muharram, and every week begins on the specified
+// weekday (usually Saturday, Sunday or Monday). Like YearDay, it returns zero for the date
+// outside the current Umm al-Qura table.
+func (uq UmmAlQuraDate) Week(firstDay time.Weekday) int64 {
+	yearDay, err := uq.yearDay()
+	if err != nil {
+		return 0
+	}
+
+	weekday, _ := uq.weekday()
+	return yearWeek(yearDay, weekday, firstDay)
+}
+
+func (uq UmmAlQuraDate) weekday() (time.Weekday, error) {
+	cjdn, err := uq.julianDayNumber()
+	if err != nil {
+		return time.Sunday, err
+	}
+
+	return jdnWeekday(cjdn), nil
+}
+
+func (uq UmmAlQuraDate) yearDay() (int64, error) {
+	cjdn, err := uq.julianDayNumber()
+	if err != nil {
+		return 0, err
+	}
+
+	newYear, err := UmmAlQuraDate{Day: 1, Month: 1, Year: uq.Year}.julianDayNumber()
+	if err != nil {
+		return 0, err
+	}
+
+	return cjdn - newYear + 1, nil
+}
+
+func (uq UmmAlQuraDate) julianDayNumber() (int64, error) {
+	start, err := currentUmmAlQuraTable().MonthStart(uq.Year, uq.Month)
+	if err != nil {
+		return 0, err
+	}
+
+	cjdn, err := timeToJDN(start)
+	if err != nil {
+		return 0, err
+	}
+
+	return cjdn + uq.Day - 1, nil
+}
+
+// UmmAlQura is the Umm al-Qura calendar, which month starts are taken from the current Umm al-Qura
+// table (see SetUmmAlQuraTable). The default table ends at 1 Muharram 1501 H, so its last complete
+// year is 1500 H.
+var UmmAlQura Calendar = ummAlQuraCalendar{}
+
+type ummAlQuraCalendar struct{}
+
+func (ummAlQuraCalendar) MonthStart(year, month int64) (time.Time, error) {
+	return currentUmmAlQuraTable().MonthStart(year, month)
+}
+
+// UmmAlQuraTable is a revision of the table of Umm al-Qura month starts. Use it directly as a
+// Calendar to pin a specific revision regardless of the current table.
+type UmmAlQuraTable struct {
+	Info TableInfo
+
+	// lunationOffset is the lunation number before the first month in the table
+	lunationOffset int64
+
+	// lunationMCJDN is the start of each lunation in Modified Chronological Julian Day Number,
+	// with the last item marks the end of the table.
+	lunationMCJDN []int64
+}
+
+// NewUmmAlQuraTable creates Umm al-Qura table from a list of month starts, with the first one as
+// the start of the specified Hijri month. Like NewTableCalendar, the last date only marks the end
+// of the table. However, since the historical tables are not always regular (e.g. Sha'ban 1364 H
+// only has 28 days), here the month starts only need to be in order. The checksum in table info
+// will be calculated from the month starts.
+func NewUmmAlQuraTable(info TableInfo, year, month int64, monthStarts []time.Time) (*UmmAlQuraTable, error) {
+	if month < 1 || month > 12 {
+		return nil, errors.New("month must be between 1 and 12")
+	}
+
+	if len(monthStarts) < 2 {
+		return nil, errors.New("table needs at least two month starts")
+	}
+
+	table := &UmmAlQuraTable{
+		Info:           info,
+		lunationOffset: lunationNumber(year, month) - 1,
+		lunationMCJDN:  make([]int64, len(monthStarts)),
+	}
+
+	for i, start := range monthStarts {
+		cjdn, err := timeToJDN(start)
+		if err != nil {
+			return nil, err
+		}
+
+		mcjdn := cjdn - 2400000
+		if i > 0 && mcjdn <= table.lunationMCJDN[i-1] {
+			return nil, fmt.Errorf("month start %s is not in order", start.Format("2006-01-02"))
+		}
+
+		table.lunationMCJDN[i] = mcjdn
+	}
+
+	table.Info.Checksum = table.checksum()
+	return table, nil
+}
+
+// MonthStart returns the Gregorian date of the first day of the specified Hijri month.
+func (table *UmmAlQuraTable) MonthStart(year, month int64) (time.Time, error) {
+	if month < 1 || month > 12 {
+		return time.Time{}, errors.New("month must be between 1 and 12")
+	}
+
+	// Get lunation index
+	iln := month + 12*(year-1)
+	lunationIdx := iln - table.lunationOffset
+	if lunationIdx < 1 || lunationIdx > int64(len(table.lunationMCJDN)) {
+		return time.Time{}, errors.New("month is outside Umm al-Qura scope")
+	}
+
+	mcjdn := table.lunationMCJDN[lunationIdx-1]
+	return jdnToTime(mcjdn + 2400000), nil
+}
+
+func (table *UmmAlQuraTable) createDate(date time.Time) (UmmAlQuraDate, error) {
 	// Convert date to UTC and set the time to noon
 	date = date.UTC()
 	date = time.Date(date.Year(), date.Month(), date.Day(), 12, 0, 0, 0, time.UTC)
 
-	// Make sure date within allowed scope
-	endOfUmmAlQura := time.Date(2077, 11, 16, 23, 59, 59, 0, time.UTC)
-	startOfUmmAlQura := time.Date(1937, 3, 14, 0, 0, 0, 0, time.UTC)
-	if date.After(endOfUmmAlQura) || date.Before(startOfUmmAlQura) {
-		return UmmAlQuraDate{}, errors.New("date is outside Umm al-Qura scope")
-	}
-
 	// Calculate Julian Days (JD)
 	jd, err := juliandays.FromTime(date)
 	if err != nil {
@@ -43,45 +228,46 @@ func CreateUmmAlQuraDate(date time.Time) (UmmAlQuraDate, error) {
 	// http://www.csgnetwork.com/julianmodifdateconv.html
 	mcjdn := cjdn - 2400000
 
+	// Make sure date within allowed scope
+	nLunations := len(table.lunationMCJDN)
+	if mcjdn < table.lunationMCJDN[0] || mcjdn >= table.lunationMCJDN[nLunations-1] {
+		return UmmAlQuraDate{}, errors.New("date is outside Umm al-Qura scope")
+	}
+
 	// Get the start of lunations day according to MCJDN data from the Umm al-Qura calendar.
 	lunationIdx := -1
-	for i := 0; i < len(ummalQuraLunationMCJDN); i++ {
-		if ummalQuraLunationMCJDN[i] > mcjdn {
+	for i := 0; i < nLunations; i++ {
+		if table.lunationMCJDN[i] > mcjdn {
 			lunationIdx = i
 			break
 		}
 	}
 
-	iln := float64(lunationIdx) + 16260
+	iln := float64(lunationIdx) + float64(table.lunationOffset)
 	ii := math.Floor((iln - 1) / 12)
 	year := int64(ii + 1)
 	month := int64(iln - 12*ii)
-	day := mcjdn - ummalQuraLunationMCJDN[lunationIdx-1] + 1
-
-	// Get weekday
-	weekday := (((cjdn + 1%7) + 7) % 7) + 1
+	day := mcjdn - table.lunationMCJDN[lunationIdx-1] + 1
 
 	return UmmAlQuraDate{
-		Day:     day,
-		Month:   month,
-		Year:    year,
-		Weekday: time.Weekday(weekday),
+		Day:   day,
+		Month: month,
+		Year:  year,
 	}, nil
 }
 
-// ToGregorian convert Umm al-Qura date to Gregorian date using Golang standard time.
-func (uq UmmAlQuraDate) ToGregorian() time.Time {
-	// Get lunation index
-	ii := uq.Year - 1
-	iln := uq.Month + 12*ii
-	lunationIdx := iln - 16260
-
-	// Get the Julian Days
-	mcjdn := uq.Day - 1 + ummalQuraLunationMCJDN[lunationIdx-1]
-	cjdn := mcjdn + 2400000
-	jd := float64(cjdn) - 0.5
+// checksum calculates SHA-256 of the table in CSV format that used by LoadTableCalendarCSV.
+func (table *UmmAlQuraTable) checksum() string {
+	entries := make([]TableEntry, len(table.lunationMCJDN))
+	for i, mcjdn := range table.lunationMCJDN {
+		year, month := lunationMonth(table.lunationOffset + int64(i) + 1)
+		entries[i] = TableEntry{
+			Gregorian: jdnToTime(mcjdn + 2400000).Format("2006-01-02"),
+			Hijri:     fmt.Sprintf("%04d-%02d-01", year, month),
+		}
+	}
 
-	return juliandays.ToTime(jd)
+	return tableChecksum(entries)
 }
 
 var ummalQuraLunationMCJDN = []int64{
@@ -0,0 +1,35 @@
+package hijri
+
+import (
+	"errors"
+	"time"
+)
+
+// UmmAlQuraRule is the criterion of Umm al-Qura calendar since 1423 H, calculated astronomically.
+// At sunset in Mecca on the local day of conjunction, if the conjunction already happened and the
+// Moon sets after the Sun, the next day starts a new month. Otherwise the month starts on the day
+// after. The official rule checks the 29th day of the previous month instead, which is usually the
+// same day.
+//
+// Since the calculation uses Delta T for the time of sunset, moonset and conjunction, it can be
+// used for years outside the Umm al-Qura table. However, the official table is occasionally
+// different from this rule, so UmmAlQuraExtended should be preferred.
+var UmmAlQuraRule Calendar = NewCrescentCalendar(WujudulHilalCriterion, Mecca)
+
+// UmmAlQuraExtended is Umm al-Qura calendar which month starts are taken from the current Umm
+// al-Qura table, then extended using UmmAlQuraRule for months outside the table.
+var UmmAlQuraExtended Calendar = ummAlQuraExtendedCalendar{}
+
+type ummAlQuraExtendedCalendar struct{}
+
+func (ummAlQuraExtendedCalendar) MonthStart(year, month int64) (time.Time, error) {
+	if month < 1 || month > 12 {
+		return time.Time{}, errors.New("month must be between 1 and 12")
+	}
+
+	if start, err := currentUmmAlQuraTable().MonthStart(year, month); err == nil {
+		return start, nil
+	}
+
+	return UmmAlQuraRule.MonthStart(year, month)
+}
@@ -0,0 +1,77 @@
+package hijri_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_UmmAlQuraRule_Table(t *testing.T) {
+	// Since 1423 H, the rule matches the official table except these months
+	expected := "[1427-06 1446-06 1485-10]"
+
+	var mismatches []string
+	for year := int64(1423); year <= 1500; year++ {
+		for month := int64(1); month <= 12; month++ {
+			tableStart, err := hijri.UmmAlQura.MonthStart(year, month)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ruleStart, err := hijri.UmmAlQuraRule.MonthStart(year, month)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !ruleStart.Equal(tableStart) {
+				mismatches = append(mismatches, fmt.Sprintf("%04d-%02d", year, month))
+			}
+		}
+	}
+
+	if result := fmt.Sprint(mismatches); result != expected {
+		t.Errorf("want different months %s got %s\n", expected, result)
+	}
+}
+
+func Test_UmmAlQuraRule_Extended(t *testing.T) {
+	// Inside the table, it's the same as Umm al-Qura
+	for _, data := range ummAlQuraTestData {
+		date, _ := time.Parse("2006-01-02", data.Gregorian)
+		result, err := hijri.CreateDate(date, hijri.UmmAlQuraExtended)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		strResult := fmt.Sprintf("%04d-%02d-%02d", result.Year, result.Month, result.Day)
+		if strResult != data.Hijri {
+			t.Errorf("%s: want %s got %s\n", data.Gregorian, data.Hijri, strResult)
+		}
+	}
+
+	// Outside the table, every month still has valid length
+	for _, year := range []int64{1340, 1501, 1502, 1600} {
+		lengths, err := hijri.MonthLengths(hijri.UmmAlQuraExtended, year)
+		if err != nil {
+			t.Fatalf("%d: %v\n", year, err)
+		}
+
+		for i, length := range lengths {
+			if length != 29 && length != 30 {
+				t.Errorf("%04d-%02d: has %d days\n", year, i+1, length)
+			}
+		}
+	}
+
+	// The first month after the table continues from its last month
+	lengths, err := hijri.MonthLengths(hijri.UmmAlQuraExtended, 1500)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if last := lengths[11]; last != 29 && last != 30 {
+		t.Errorf("1500-12: has %d days\n", last)
+	}
+}
@@ -43,11 +43,10 @@ func Test_UmmAlQura_ToGregorian(t *testing.T) {
 	}
 
 	for _, data := range ummAlQuraTestData {
-		var ummAlQuraDate hijri.UmmAlQuraDate
-		fmt.Sscanf(data.Hijri, "%d-%d-%d",
-			&ummAlQuraDate.Year,
-			&ummAlQuraDate.Month,
-			&ummAlQuraDate.Day)
+		ummAlQuraDate, err := hijri.ParseUmmAlQuraDate(hijri.LayoutISO, data.Hijri)
+		if err != nil {
+			t.Fatal(err)
+		}
 
 		result := ummAlQuraDate.ToGregorian().Format("2006-01-02")
 		if result != data.Gregorian {
@@ -84,3 +83,26 @@ func Test_UmmAlQura_Bidirectional(t *testing.T) {
 		date = date.AddDate(0, 0, 1)
 	}
 }
+
+func Test_UmmAlQura_CalendarFields(t *testing.T) {
+	for _, data := range ummAlQuraTestData {
+		gregorianDate, _ := time.Parse("2006-01-02", data.Gregorian)
+		ummAlQuraDate, _ := hijri.CreateUmmAlQuraDate(gregorianDate)
+
+		if weekday := ummAlQuraDate.Weekday(); weekday != gregorianDate.Weekday() {
+			t.Errorf("%s: want %s got %s\n", data.Hijri, gregorianDate.Weekday(), weekday)
+		}
+
+		newYear := hijri.UmmAlQuraDate{Day: 1, Month: 1, Year: ummAlQuraDate.Year}
+		yearDay := int64(gregorianDate.Sub(newYear.ToGregorian()).Hours()/24) + 1
+		if result := ummAlQuraDate.YearDay(); result != yearDay {
+			t.Errorf("%s: want day %d got %d\n", data.Hijri, yearDay, result)
+		}
+
+		weekStart := gregorianDate.AddDate(0, 0, -int(gregorianDate.Weekday()-time.Saturday+7)%7)
+		week := (int64(weekStart.Sub(newYear.ToGregorian()).Hours()/24)+6)/7 + 1
+		if result := ummAlQuraDate.Week(time.Saturday); result != week {
+			t.Errorf("%s: want week %d got %d\n", data.Hijri, week, result)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package hijri
+
+// Visibility is the prediction of how the crescent can be seen.
+type Visibility int
+
+const (
+	// NotVisible means the crescent can't be seen, even with telescope.
+	NotVisible Visibility = iota
+
+	// OpticalAid means the crescent can only be seen with binoculars or telescope.
+	OpticalAid
+
+	// NakedEye means the crescent can be seen without any optical aid.
+	NakedEye
+)
+
+// String returns the description of the visibility.
+func (v Visibility) String() string {
+	switch v {
+	case NotVisible:
+		return "not visible"
+	case OpticalAid:
+		return "optical aid"
+	case NakedEye:
+		return "naked eye"
+	default:
+		return "unknown"
+	}
+}
+
+// VisibilityTest is the result of a crescent visibility criterion.
+type VisibilityTest struct {
+	// Value is the numeric parameter of the criterion, e.g. q for Yallop and V for Odeh.
+	Value float64
+
+	// Zone is the visibility zone as defined by the criterion, from "A" for the most visible.
+	Zone string
+
+	Visibility Visibility
+}
+
+// YallopTest predicts the crescent visibility using q-test by B.D. Yallop in "A Method for
+// Predicting the First Sighting of the New Crescent Moon" (NAO Technical Note 69, 1997). The
+// zones are A (easily visible), B (visible under perfect conditions), C (may need optical aid to
+// find the crescent), D (will need optical aid), E (not visible with telescope) and F (below the
+// Danjon limit).
+func YallopTest(crescent CrescentObservation) VisibilityTest {
+	w := crescent.Width
+	q := (crescent.ARCV - (11.8371 - 6.3226*w + 0.7319*w*w - 0.1018*w*w*w)) / 10
+
+	switch {
+	case q > 0.216:
+		return VisibilityTest{Value: q, Zone: "A", Visibility: NakedEye}
+	case q > -0.014:
+		return VisibilityTest{Value: q, Zone: "B", Visibility: NakedEye}
+	case q > -0.160:
+		return VisibilityTest{Value: q, Zone: "C", Visibility: OpticalAid}
+	case q > -0.232:
+		return VisibilityTest{Value: q, Zone: "D", Visibility: OpticalAid}
+	case q > -0.293:
+		return VisibilityTest{Value: q, Zone: "E", Visibility: NotVisible}
+	default:
+		return VisibilityTest{Value: q, Zone: "F", Visibility: NotVisible}
+	}
+}
+
+// OdehTest predicts the crescent visibility using V criterion by M.S. Odeh in "New Criterion for
+// Lunar Crescent Visibility" (Experimental Astronomy 18, 2004). The zones are A (visible by naked
+// eye), B (visible by optical aid, and could be seen by naked eye), C (visible by optical aid
+// only) and D (not visible even by optical aid).
+func OdehTest(crescent CrescentObservation) VisibilityTest {
+	w := crescent.Width
+	v := crescent.TopocentricARCV - (7.1651 - 6.3226*w + 0.7319*w*w - 0.1018*w*w*w)
+
+	switch {
+	case v >= 5.65:
+		return VisibilityTest{Value: v, Zone: "A", Visibility: NakedEye}
+	case v >= 2:
+		return VisibilityTest{Value: v, Zone: "B", Visibility: NakedEye}
+	case v >= -0.96:
+		return VisibilityTest{Value: v, Zone: "C", Visibility: OpticalAid}
+	default:
+		return VisibilityTest{Value: v, Zone: "D", Visibility: NotVisible}
+	}
+}
@@ -0,0 +1,70 @@
+package hijri_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+	"github.com/hablullah/go-hijri/astro"
+)
+
+func Test_Visibility_Criteria(t *testing.T) {
+	tests := []struct {
+		Date     time.Time
+		Observer astro.Observer
+		Yallop   string
+		Odeh     string
+	}{
+		// Shawwal 1444 H: not visible on the day of conjunction, easily visible on the next day
+		{time.Date(2023, 4, 20, 0, 0, 0, 0, time.UTC), hijri.Jakarta, "F", "D"},
+		{time.Date(2023, 4, 20, 0, 0, 0, 0, time.UTC), hijri.Mecca, "F", "D"},
+		{time.Date(2023, 4, 21, 0, 0, 0, 0, time.UTC), hijri.Jakarta, "A", "A"},
+		{time.Date(2023, 4, 21, 0, 0, 0, 0, time.UTC), hijri.Mecca, "A", "A"},
+
+		// Ramadan 1445 H: conjunction on 10 March 2024 at 09:00 UTC
+		{time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), hijri.Mecca, "F", "D"},
+		{time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), hijri.Mecca, "A", "A"},
+	}
+
+	for _, test := range tests {
+		crescent, err := hijri.ObserveCrescent(test.Date, test.Observer)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		date := test.Date.Format("2006-01-02")
+		if result := hijri.YallopTest(crescent); result.Zone != test.Yallop {
+			t.Errorf("%s: want Yallop zone %s got %s (q = %f)\n", date, test.Yallop, result.Zone, result.Value)
+		}
+
+		if result := hijri.OdehTest(crescent); result.Zone != test.Odeh {
+			t.Errorf("%s: want Odeh zone %s got %s (V = %f)\n", date, test.Odeh, result.Zone, result.Value)
+		}
+	}
+}
+
+func Test_Visibility_Zones(t *testing.T) {
+	// Crescent with width 0.5' is around the boundary of naked eye visibility when ARCV is 10°,
+	// or 7° when measured by the observer. Topocentric ARCV is lower because of the parallax.
+	crescent := hijri.CrescentObservation{ARCV: 10, TopocentricARCV: 7, Width: 0.5}
+
+	yallop := hijri.YallopTest(crescent)
+	if yallop.Zone != "B" || yallop.Visibility != hijri.NakedEye {
+		t.Errorf("want Yallop zone B got %s (q = %f)\n", yallop.Zone, yallop.Value)
+	}
+
+	odeh := hijri.OdehTest(crescent)
+	if odeh.Zone != "B" || odeh.Visibility != hijri.NakedEye {
+		t.Errorf("want Odeh zone B got %s (V = %f)\n", odeh.Zone, odeh.Value)
+	}
+
+	// Without any width, even 6.5° ARCV is not enough for naked eye
+	crescent = hijri.CrescentObservation{ARCV: 6.5, TopocentricARCV: 6.5}
+	if result := hijri.YallopTest(crescent); result.Visibility != hijri.NotVisible {
+		t.Errorf("want not visible got %s (q = %f)\n", result.Visibility, result.Value)
+	}
+
+	if result := hijri.OdehTest(crescent); result.Visibility != hijri.OpticalAid {
+		t.Errorf("want optical aid got %s (V = %f)\n", result.Visibility, result.Value)
+	}
+}
@@ -0,0 +1,258 @@
+package hijri
+
+import (
+	"encoding/json"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/hablullah/go-hijri/astro"
+)
+
+// Default area and resolution of the visibility map, in degrees.
+const (
+	DefaultMapMaxLatitude = 60
+	DefaultMapStep        = 2
+)
+
+// mapColors is the color of each visibility in the PNG image.
+var mapColors = map[Visibility]color.RGBA{
+	NotVisible: {R: 0xe8, G: 0xe8, B: 0xe8, A: 0xff},
+	OpticalAid: {R: 0xf5, G: 0xb0, B: 0x41, A: 0xff},
+	NakedEye:   {R: 0x3c, G: 0xa5, B: 0x5c, A: 0xff},
+}
+
+// mapGridColor is the color of the meridians and parallels in the PNG image.
+var mapGridColor = color.RGBA{R: 0x90, G: 0x90, B: 0x90, A: 0xff}
+
+// VisibilityMap is the crescent visibility over a grid of latitude and longitude on an evening.
+// The grid starts from the north-west corner, and each cell is checked at its center. If the area
+// is not a multiple of the step, the cells in the last row and column are cut at the border of the
+// map, so every cell is checked inside the map.
+type VisibilityMap struct {
+	// Date is the evening which crescent is checked, as the local date at every cell.
+	Date time.Time
+
+	// MaxLatitude limits the map between MaxLatitude north and south, while Step is the size of
+	// each cell, both in degrees.
+	MaxLatitude float64
+	Step        float64
+
+	Rows  int
+	Cols  int
+	Cells []Visibility
+}
+
+// NewVisibilityMap creates crescent visibility map for the evening of the specified date, using the
+// visibility test (e.g. YallopTest or OdehTest). The cells where the Sun or the Moon doesn't set are
+// marked as not visible.
+func NewVisibilityMap(date time.Time, test func(CrescentObservation) VisibilityTest, maxLatitude, step float64) (*VisibilityMap, error) {
+	if step <= 0 || maxLatitude <= 0 || maxLatitude > 90 {
+		return nil, errors.New("invalid map area or step")
+	}
+
+	vm := &VisibilityMap{
+		Date:        time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC),
+		MaxLatitude: maxLatitude,
+		Step:        step,
+		Rows:        int(math.Ceil(2 * maxLatitude / step)),
+		Cols:        int(math.Ceil(360 / step)),
+	}
+	vm.Cells = make([]Visibility, vm.Rows*vm.Cols)
+
+	// Check each row concurrently, since every cell needs a full ephemeris calculation
+	rows := make(chan int)
+	wg := sync.WaitGroup{}
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for row := range rows {
+				for col := 0; col < vm.Cols; col++ {
+					crescent, err := ObserveCrescent(vm.Date, vm.cellObserver(row, col))
+					if err == nil && crescent.Age > 0 {
+						vm.Cells[row*vm.Cols+col] = test(crescent).Visibility
+					}
+				}
+			}
+		}()
+	}
+
+	for row := 0; row < vm.Rows; row++ {
+		rows <- row
+	}
+
+	close(rows)
+	wg.Wait()
+	return vm, nil
+}
+
+// MonthVisibilityMap creates crescent visibility map for the evening before the specified month
+// starts in the calendar, with the default area and resolution.
+func MonthVisibilityMap(cal Calendar, year, month int64, test func(CrescentObservation) VisibilityTest) (*VisibilityMap, error) {
+	start, err := cal.MonthStart(year, month)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewVisibilityMap(start.AddDate(0, 0, -1), test, DefaultMapMaxLatitude, DefaultMapStep)
+}
+
+// At returns the visibility at the specified location. Locations outside the map are not visible.
+func (vm *VisibilityMap) At(latitude, longitude float64) Visibility {
+	row := int(math.Floor((vm.MaxLatitude - latitude) / vm.Step))
+	col := int(math.Floor((normalizeLongitude(longitude) + 180) / vm.Step))
+	if row < 0 || row >= vm.Rows || col < 0 || col >= vm.Cols {
+		return NotVisible
+	}
+
+	return vm.Cells[row*vm.Cols+col]
+}
+
+// WritePNG draws the map as PNG image in equirectangular projection, with the specified size of
+// each cell in pixels. Meridians and parallels are drawn every 30 degrees.
+func (vm *VisibilityMap) WritePNG(w io.Writer, cellSize int) error {
+	if cellSize < 1 {
+		return errors.New("cell size must be at least one pixel")
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, vm.Cols*cellSize, vm.Rows*cellSize))
+	for row := 0; row < vm.Rows; row++ {
+		for col := 0; col < vm.Cols; col++ {
+			c := mapColors[vm.Cells[row*vm.Cols+col]]
+			for y := row * cellSize; y < (row+1)*cellSize; y++ {
+				for x := col * cellSize; x < (col+1)*cellSize; x++ {
+					img.SetRGBA(x, y, c)
+				}
+			}
+		}
+	}
+
+	// Draw the grid lines
+	bounds := img.Bounds()
+	pixelSize := vm.Step / float64(cellSize)
+	for x := bounds.Min.X; x < bounds.Max.X; x++ {
+		if isGridLine(-180+float64(x)*pixelSize, pixelSize) {
+			for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+				img.SetRGBA(x, y, mapGridColor)
+			}
+		}
+	}
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		if isGridLine(vm.MaxLatitude-float64(y)*pixelSize, pixelSize) {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				img.SetRGBA(x, y, mapGridColor)
+			}
+		}
+	}
+
+	return png.Encode(w, img)
+}
+
+// WriteGeoJSON writes the map as GeoJSON feature collection, with a MultiPolygon feature for each
+// zone where the crescent is visible by naked eye or by optical aid. The polygons are the grid
+// cells of the zone rather than its smoothed contour, so their edges follow the map step.
+func (vm *VisibilityMap) WriteGeoJSON(w io.Writer) error {
+	type geometry struct {
+		Type        string          `json:"type"`
+		Coordinates [][][][]float64 `json:"coordinates"`
+	}
+
+	type feature struct {
+		Type       string            `json:"type"`
+		Properties map[string]string `json:"properties"`
+		Geometry   geometry          `json:"geometry"`
+	}
+
+	type featureCollection struct {
+		Type     string    `json:"type"`
+		Features []feature `json:"features"`
+	}
+
+	collection := featureCollection{Type: "FeatureCollection", Features: []feature{}}
+	for _, visibility := range []Visibility{NakedEye, OpticalAid} {
+		polygons := vm.zonePolygons(visibility)
+		if len(polygons) == 0 {
+			continue
+		}
+
+		collection.Features = append(collection.Features, feature{
+			Type: "Feature",
+			Properties: map[string]string{
+				"date":       vm.Date.Format("2006-01-02"),
+				"visibility": visibility.String(),
+			},
+			Geometry: geometry{Type: "MultiPolygon", Coordinates: polygons},
+		})
+	}
+
+	return json.NewEncoder(w).Encode(collection)
+}
+
+// zonePolygons returns the cells with the specified visibility as GeoJSON polygons, where the
+// consecutive cells in a row are merged into a single rectangle.
+func (vm *VisibilityMap) zonePolygons(visibility Visibility) [][][][]float64 {
+	var polygons [][][][]float64
+	for row := 0; row < vm.Rows; row++ {
+		for col := 0; col < vm.Cols; col++ {
+			if vm.Cells[row*vm.Cols+col] != visibility {
+				continue
+			}
+
+			// Find the end of this run of cells
+			end := col
+			for end+1 < vm.Cols && vm.Cells[row*vm.Cols+end+1] == visibility {
+				end++
+			}
+
+			north, south, west, _ := vm.cellBounds(row, col)
+			_, _, _, east := vm.cellBounds(row, end)
+			polygons = append(polygons, [][][]float64{{
+				{west, south}, {east, south}, {east, north}, {west, north}, {west, south},
+			}})
+
+			col = end
+		}
+	}
+
+	return polygons
+}
+
+// cellBounds returns the latitude and longitude of the cell borders, cut at the border of the map.
+func (vm *VisibilityMap) cellBounds(row, col int) (north, south, west, east float64) {
+	north = vm.MaxLatitude - float64(row)*vm.Step
+	south = math.Max(north-vm.Step, -vm.MaxLatitude)
+	west = -180 + float64(col)*vm.Step
+	east = math.Min(west+vm.Step, 180)
+	return
+}
+
+// cellObserver returns the observer at the center of the cell.
+func (vm *VisibilityMap) cellObserver(row, col int) astro.Observer {
+	north, south, west, east := vm.cellBounds(row, col)
+	return astro.Observer{
+		Latitude:  (north + south) / 2,
+		Longitude: (west + east) / 2,
+	}
+}
+
+// isGridLine checks whether a pixel starting at the angle contains a multiple of 30 degrees.
+func isGridLine(angle, pixelSize float64) bool {
+	return math.Ceil(angle/30)*30 < angle+pixelSize
+}
+
+// normalizeLongitude returns the longitude in range [-180, 180).
+func normalizeLongitude(longitude float64) float64 {
+	longitude = math.Mod(longitude+180, 360)
+	if longitude < 0 {
+		longitude += 360
+	}
+	return longitude - 180
+}
@@ -0,0 +1,151 @@
+package hijri_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/hablullah/go-hijri"
+)
+
+func Test_VisibilityMap_Shawwal(t *testing.T) {
+	// Conjunction of Shawwal 1447 H is on 19 March 2026 at 01:23 UTC. That evening the crescent
+	// is visible in the Americas, but not in Indonesia.
+	date := time.Date(2026, 3, 19, 0, 0, 0, 0, time.UTC)
+	vm, err := hijri.NewVisibilityMap(date, hijri.OdehTest, 60, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if vm.Rows != 12 || vm.Cols != 36 || len(vm.Cells) != 12*36 {
+		t.Fatalf("want 12x36 cells got %dx%d\n", vm.Rows, vm.Cols)
+	}
+
+	tests := []struct {
+		Name      string
+		Latitude  float64
+		Longitude float64
+		Expected  hijri.Visibility
+	}{
+		{"Quito", -0.18, -78.47, hijri.NakedEye},
+		{"Dakar", 14.72, -17.47, hijri.NakedEye},
+		{"Jakarta", -6.175, 106.8275, hijri.NotVisible},
+		{"Tokyo", 35.68, 139.69, hijri.NotVisible},
+	}
+
+	for _, test := range tests {
+		if result := vm.At(test.Latitude, test.Longitude); result != test.Expected {
+			t.Errorf("%s: want %s got %s\n", test.Name, test.Expected, result)
+		}
+	}
+
+	// Outside the map, the crescent is always not visible
+	if result := vm.At(80, 0); result != hijri.NotVisible {
+		t.Errorf("want not visible outside the map got %s\n", result)
+	}
+}
+
+func Test_VisibilityMap_Output(t *testing.T) {
+	vm, err := hijri.MonthVisibilityMap(hijri.FCNA, 1447, 10, hijri.YallopTest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := vm.Date.Format("2006-01-02"); result != "2026-03-19" {
+		t.Errorf("want map for 2026-03-19 got %s\n", result)
+	}
+
+	// PNG image has the size of the grid
+	buffer := bytes.NewBuffer(nil)
+	if err = vm.WritePNG(buffer, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	img, err := png.Decode(buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if size := img.Bounds().Size(); size.X != vm.Cols*2 || size.Y != vm.Rows*2 {
+		t.Errorf("want image %dx%d got %dx%d\n", vm.Cols*2, vm.Rows*2, size.X, size.Y)
+	}
+
+	// GeoJSON has feature for each visible zone
+	buffer.Reset()
+	if err = vm.WriteGeoJSON(buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	var collection struct {
+		Type     string
+		Features []struct {
+			Properties map[string]string
+			Geometry   struct {
+				Type        string
+				Coordinates [][][][]float64
+			}
+		}
+	}
+
+	if err = json.Unmarshal(buffer.Bytes(), &collection); err != nil {
+		t.Fatal(err)
+	}
+
+	if collection.Type != "FeatureCollection" || len(collection.Features) != 2 {
+		t.Fatalf("want feature collection with 2 features got %s with %d\n", collection.Type, len(collection.Features))
+	}
+
+	for _, feature := range collection.Features {
+		if feature.Geometry.Type != "MultiPolygon" || len(feature.Geometry.Coordinates) == 0 {
+			t.Errorf("%s: invalid geometry\n", feature.Properties["visibility"])
+		}
+
+		for _, polygon := range feature.Geometry.Coordinates {
+			if ring := polygon[0]; len(ring) != 5 || ring[0][0] != ring[4][0] || ring[0][1] != ring[4][1] {
+				t.Errorf("%s: polygon is not closed\n", feature.Properties["visibility"])
+			}
+		}
+	}
+}
+
+func Test_VisibilityMap_Border(t *testing.T) {
+	// The area is not a multiple of the step, so the last row and column must be cut at the border
+	date := time.Date(2026, 3, 19, 0, 0, 0, 0, time.UTC)
+	vm, err := hijri.NewVisibilityMap(date, hijri.OdehTest, 25, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	if err = vm.WriteGeoJSON(buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	var collection struct {
+		Features []struct {
+			Geometry struct {
+				Coordinates [][][][]float64
+			}
+		}
+	}
+
+	if err = json.Unmarshal(buffer.Bytes(), &collection); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(collection.Features) == 0 {
+		t.Fatal("want visible zones got none")
+	}
+
+	for _, feature := range collection.Features {
+		for _, polygon := range feature.Geometry.Coordinates {
+			for _, point := range polygon[0] {
+				if point[0] < -180 || point[0] > 180 || point[1] < -25 || point[1] > 25 {
+					t.Errorf("point %v is outside the map\n", point)
+				}
+			}
+		}
+	}
+}